@@ -20,6 +20,8 @@ import (
 	"os"
 
 	"github.com/linuxsuren/api-testing/pkg/downloader"
+	"github.com/linuxsuren/api-testing/pkg/mock"
+	"github.com/linuxsuren/api-testing/pkg/runner"
 
 	"github.com/linuxsuren/api-testing/pkg/server"
 	"github.com/linuxsuren/api-testing/pkg/version"
@@ -35,12 +37,13 @@ func NewRootCmd(execer fakeruntime.Execer, httpServer server.HTTPServer) (c *cob
 	}
 	c.SetOut(os.Stdout)
 	c.Version = "\n" + version.GetDetailedVersion()
+	mock.SetProtoLoader(runner.LoadProtoDescriptors)
 	c.AddCommand(createInitCommand(execer),
 		createRunCommand(), createSampleCmd(), createMockComposeCmd(),
 		createServerCmd(execer, httpServer), createJSONSchemaCmd(),
 		createServiceCommand(execer), createFunctionCmd(), createConvertCommand(),
 		createMockCmd(), createExtensionCommand(downloader.NewStoreDownloader()),
-		createComposeRun())
+		createComposeRun(), createSecretCmd())
 	return
 }
 
@@ -34,9 +34,12 @@ import (
 
 	"github.com/linuxsuren/api-testing/pkg/apispec"
 	"github.com/linuxsuren/api-testing/pkg/logging"
+	"github.com/linuxsuren/api-testing/pkg/render"
 	"github.com/linuxsuren/api-testing/pkg/runner"
 	"github.com/linuxsuren/api-testing/pkg/runner/monitor"
 	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/testing/local"
+	"github.com/linuxsuren/api-testing/pkg/testing/remote"
 	"github.com/linuxsuren/api-testing/pkg/util"
 
 	fakeruntime "github.com/linuxsuren/go-fake-runtime"
@@ -70,6 +73,11 @@ type runOption struct {
 	caseItems          []string
 	githubReportOption *runner.GithubPRCommentOption
 	monitorDocker      string
+	notificationConfig string
+	notifier           runner.Notifier
+	env                string
+	configDir          string
+	envVariables       map[string]string
 
 	// for internal use
 	loader testing.Loader
@@ -139,6 +147,9 @@ func (o *runOption) addFlags(flags *pflag.FlagSet) {
 	flags.Int32VarP(&o.qps, "qps", "", 5, "QPS")
 	flags.IntVarP(&o.burst, "burst", "", 5, "burst")
 	flags.StringVarP(&o.monitorDocker, "monitor-docker", "", "", "The docker container name to monitor")
+	flags.StringVarP(&o.env, "env", "", "", "The name of the environment which provides the variables for {{.env.XXX}}")
+	flags.StringVarP(&o.configDir, "config-dir", "", home.GetUserConfigDir(), "The config directory of the environments and secrets")
+	flags.StringVarP(&o.notificationConfig, "notification-config", "", "", "The config file of the notification rules which will be sent after each test suite run")
 }
 
 func (o *runOption) preRunE(cmd *cobra.Command, args []string) (err error) {
@@ -200,6 +211,8 @@ func (o *runOption) preRunE(cmd *cobra.Command, args []string) (err error) {
 	}
 
 	if err == nil {
+		o.reportWriter = runner.NewMaskResultWriter(o.reportWriter)
+
 		var swaggerAPI apispec.SwaggerAPI
 		if o.swaggerURL != "" {
 			if swaggerAPI.Swagger, err = apispec.ParseURLToSwagger(o.swaggerURL); err == nil {
@@ -208,6 +221,17 @@ func (o *runOption) preRunE(cmd *cobra.Command, args []string) (err error) {
 		}
 	}
 
+	if err == nil && o.notificationConfig != "" {
+		if _, err = runner.LoadNotificationConfig(o.notificationConfig); err == nil {
+			o.notifier = runner.NewNotifier(o.notificationConfig).
+				WithStateFile(filepath.Join(home.GetUserDataDir(), "notification-state.yaml"))
+		}
+	}
+
+	if err == nil && o.env != "" {
+		err = o.loadEnvironment()
+	}
+
 	if err == nil {
 		err = o.startMonitor()
 	}
@@ -216,6 +240,15 @@ func (o *runOption) preRunE(cmd *cobra.Command, args []string) (err error) {
 	return
 }
 
+func (o *runOption) loadEnvironment() (err error) {
+	var env *testing.Environment
+	if env, err = testing.NewEnvironmentFactory(o.configDir).GetEnvironment(o.env); err == nil {
+		secretGetter := render.NewMaskSecretGetter(remote.NewGRPCSecretGetter(local.NewLocalSecretService(o.configDir)))
+		o.envVariables, err = env.Resolve(secretGetter)
+	}
+	return
+}
+
 const extensionMonitor = "atest-monitor-docker"
 
 func (o *runOption) startMonitor() (err error) {
@@ -260,6 +293,14 @@ func (o *runOption) runE(cmd *cobra.Command, args []string) (err error) {
 	defer func() {
 		cmd.Printf("Consumed: %s\n", time.Since(o.startTime).String())
 	}()
+	defer func() {
+		closeErr := runner.CloseGRPCConnections()
+		println(cmd, closeErr, "failed to close gRPC connections", closeErr)
+	}()
+	defer func() {
+		closeErr := runner.CloseSQLDatabases()
+		println(cmd, closeErr, "failed to close SQL databases", closeErr)
+	}()
 
 	if err = o.loader.Put(o.pattern); err != nil {
 		return
@@ -284,6 +325,13 @@ func (o *runOption) runE(cmd *cobra.Command, args []string) (err error) {
 		outputErr := o.reportWriter.Output(results)
 		println(cmd, outputErr, "failed to Output all reports", outputErr)
 	}
+	for _, coverage := range runner.GetGraphQLCoverage() {
+		cmd.Printf("GraphQL Coverage of %s: types %d/%d, fields %d/%d\n", coverage.Schema,
+			coverage.CoveredTypes, coverage.TotalTypes, coverage.CoveredFields, coverage.TotalFields)
+		if len(coverage.Uncovered) > 0 {
+			cmd.Printf("Uncovered GraphQL fields: %s\n", strings.Join(coverage.Uncovered, ", "))
+		}
+	}
 	println(cmd, reportErr, "failed to export all reports", reportErr)
 	return
 }
@@ -354,16 +402,34 @@ func (o *runOption) runSuite(loader testing.Loader, dataContext map[string]inter
 		return
 	}
 
+	if o.envVariables != nil {
+		dataContext[testing.ContextKeyEnv] = o.envVariables
+	}
+
 	runLogger.Info("render test suite", "name", testSuite.Name)
 	if err = testSuite.Render(dataContext); err != nil {
 		return
 	}
 
+	// only notify for the single run, the duration mode is for performance testing
+	var summary *runner.RunSummary
+	if o.notifier != nil && o.duration <= 0 {
+		summary = runner.NewRunSummary(testSuite.Name)
+		defer func() {
+			summary.Finish(err)
+			if notifyErr := o.notifier.Notify(summary); notifyErr != nil {
+				runLogger.Info("failed to send notification", "error", notifyErr)
+			}
+		}()
+	}
+
 	var errs []error
 	suiteRunner := runner.GetTestSuiteRunner(testSuite)
 	suiteRunner.WithTestReporter(o.reporter)
 	suiteRunner.WithSecure(testSuite.Spec.Secure)
-	suiteRunner.WithOutputWriter(o.reportWriter.GetWriter())
+	outputWriter := o.reportWriter.GetWriter()
+	defer render.FlushMaskWriter(outputWriter)
+	suiteRunner.WithOutputWriter(outputWriter)
 	suiteRunner.WithWriteLevel(o.level)
 	suiteRunner.WithSuite(testSuite)
 	var caseFilterObj interface{}
@@ -406,6 +472,9 @@ func (o *runOption) runSuite(loader testing.Loader, dataContext map[string]inter
 			ctxWithTimeout = context.WithValue(ctxWithTimeout, runner.ContextKey("").ParentDir(), loader.GetContext())
 
 			output, err = suiteRunner.RunTestCase(&testCase, dataContext, ctxWithTimeout)
+			if summary != nil {
+				summary.AddCase(testCase.Name, "", err)
+			}
 			if err = util.ErrorWrap(err, "failed to run '%s', %v", testCase.Name, err); err != nil {
 				if o.requestIgnoreError {
 					errs = append(errs, err)
@@ -248,6 +248,15 @@ func TestPreRunE(t *testing.T) {
 			assert.Nil(t, err)
 			assert.NotNil(t, ro.reportWriter)
 		},
+	}, {
+		name: "notification config not found",
+		opt: &runOption{
+			notificationConfig: "testdata/fake.yaml",
+		},
+		verify: func(t *testing.T, ro *runOption, err error) {
+			assert.NotNil(t, err)
+			assert.Nil(t, ro.notifier)
+		},
 	}, {
 		name: "invalid report",
 		opt: &runOption{
@@ -267,6 +276,44 @@ func TestPreRunE(t *testing.T) {
 	}
 }
 
+func TestRunWithEnvironment(t *testing.T) {
+	configDir := t.TempDir()
+	err := atest.NewEnvironmentFactory(configDir).CreateEnvironment(atest.Environment{
+		Name:      "staging",
+		Variables: map[string]string{"SERVER": urlFoo},
+	})
+	assert.NoError(t, err)
+
+	suiteFile := path.Join(configDir, "suite.yaml")
+	err = os.WriteFile(suiteFile, []byte(`name: env
+api: "{{.env.SERVER}}"
+items:
+- name: bar
+  request:
+    api: /bar`), 0644)
+	assert.NoError(t, err)
+
+	t.Run("normal", func(t *testing.T) {
+		defer gock.Off()
+		gock.New(urlFoo).Get("/bar").Reply(http.StatusOK).JSON("{}")
+
+		root := &cobra.Command{Use: "root"}
+		root.SetOut(new(bytes.Buffer))
+		root.AddCommand(createRunCommand())
+		root.SetArgs([]string{"run", "-p", suiteFile, "--env", "staging", "--config-dir", configDir})
+		assert.NoError(t, root.Execute())
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("environment not found", func(t *testing.T) {
+		root := &cobra.Command{Use: "root"}
+		root.SetOut(new(bytes.Buffer))
+		root.AddCommand(createRunCommand())
+		root.SetArgs([]string{"run", "-p", suiteFile, "--env", "fake", "--config-dir", configDir})
+		assert.Error(t, root.Execute())
+	})
+}
+
 func TestPrinter(t *testing.T) {
 	buf := new(bytes.Buffer)
 	c := &cobra.Command{}
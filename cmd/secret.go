@@ -0,0 +1,100 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/linuxsuren/api-testing/pkg/secret"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/testing/local"
+	"github.com/linuxsuren/api-testing/pkg/util/home"
+	"github.com/spf13/cobra"
+)
+
+func createSecretCmd() (c *cobra.Command) {
+	c = &cobra.Command{
+		Use:   "secret",
+		Short: "Manage the local secrets",
+	}
+	c.AddCommand(createSecretRotateKeyCmd())
+	return
+}
+
+type secretRotateKeyOption struct {
+	configDir string
+	newKey    string
+}
+
+func createSecretRotateKeyCmd() (c *cobra.Command) {
+	opt := &secretRotateKeyOption{}
+	c = &cobra.Command{
+		Use:   "rotate-key",
+		Short: "Re-encrypt the local secrets and the passwords of stores with a new master key",
+		Example: `atest secret rotate-key
+ATEST_SECRET_KEY=old atest secret rotate-key --new-key new`,
+		RunE: opt.runE,
+	}
+	flags := c.Flags()
+	flags.StringVarP(&opt.configDir, "config-dir", "", home.GetUserConfigDir(), "The config directory")
+	flags.StringVarP(&opt.newKey, "new-key", "", "", "The new master key, a random one will be generated if it's empty")
+	return
+}
+
+func (o *secretRotateKeyOption) runE(c *cobra.Command, args []string) (err error) {
+	fromEnv := os.Getenv(secret.EnvMasterKey) != ""
+	if fromEnv && o.newKey == "" {
+		err = fmt.Errorf("--new-key is required when the master key comes from $%s", secret.EnvMasterKey)
+		return
+	}
+
+	var oldKey string
+	if oldKey, err = secret.GetMasterKey(o.configDir); err != nil {
+		return
+	}
+
+	newKey := o.newKey
+	if newKey == "" {
+		if newKey, err = secret.GenerateMasterKey(); err != nil {
+			return
+		}
+	}
+
+	oldCipher, newCipher := secret.NewCipher(oldKey), secret.NewCipher(newKey)
+	rotators := []secret.Rotator{
+		local.NewLocalSecretService(o.configDir).(secret.Rotator),
+		testing.NewStoreFactory(o.configDir).(secret.Rotator),
+	}
+	for i, rotator := range rotators {
+		if err = rotator.RotateSecret(oldCipher, newCipher); err != nil {
+			// roll back the rotated ones, otherwise they cannot be decrypted with the old key
+			for j := 0; j < i; j++ {
+				err = errors.Join(err, rotators[j].RotateSecret(newCipher, oldCipher))
+			}
+			return
+		}
+	}
+
+	if fromEnv {
+		c.Printf("the secrets were re-encrypted, please set $%s to the new key\n", secret.EnvMasterKey)
+	} else if err = secret.SaveMasterKey(o.configDir, newKey); err == nil {
+		c.Println("the master key was rotated")
+	}
+	return
+}
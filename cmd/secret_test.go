@@ -0,0 +1,101 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/pkg/secret"
+	"github.com/linuxsuren/api-testing/pkg/server"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/testing/local"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretRotateKey(t *testing.T) {
+	configDir := t.TempDir()
+	ctx := context.Background()
+	assert.NoError(t, secret.InitMasterKey(configDir))
+	oldKey, err := secret.GetMasterKey(configDir)
+	assert.NoError(t, err)
+
+	secretService := local.NewLocalSecretService(configDir)
+	_, err = secretService.CreateSecret(ctx, &server.Secret{Name: "token", Value: "value"})
+	assert.NoError(t, err)
+	storeFactory := atest.NewStoreFactory(configDir)
+	assert.NoError(t, storeFactory.CreateStore(atest.Store{Name: "db", Password: "password"}))
+
+	execute := func(args ...string) (string, error) {
+		buf := new(bytes.Buffer)
+		root := &cobra.Command{Use: "root"}
+		root.SetOut(buf)
+		root.AddCommand(createSecretCmd())
+		root.SetArgs(append([]string{"secret", "rotate-key", "--config-dir", configDir}, args...))
+		err := root.Execute()
+		return buf.String(), err
+	}
+
+	output, err := execute()
+	assert.NoError(t, err)
+	assert.Contains(t, output, "the master key was rotated")
+
+	var newKey string
+	newKey, err = secret.GetMasterKey(configDir)
+	assert.NoError(t, err)
+	assert.NotEqual(t, oldKey, newKey)
+
+	var item *server.Secret
+	item, err = secretService.GetSecret(ctx, &server.Secret{Name: "token"})
+	assert.NoError(t, err)
+	assert.Equal(t, "value", item.Value)
+
+	var store *atest.Store
+	store, err = storeFactory.GetStore("db")
+	assert.NoError(t, err)
+	assert.Equal(t, "password", store.Password)
+
+	var data []byte
+	data, err = os.ReadFile(filepath.Join(configDir, "stores.yaml"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "password: password")
+
+	t.Run("master key from env", func(t *testing.T) {
+		t.Setenv(secret.EnvMasterKey, newKey)
+		_, err := execute()
+		assert.Error(t, err)
+
+		output, err := execute("--new-key", "env-key")
+		assert.NoError(t, err)
+		assert.Contains(t, output, secret.EnvMasterKey)
+
+		t.Setenv(secret.EnvMasterKey, "env-key")
+		store, err := storeFactory.GetStore("db")
+		assert.NoError(t, err)
+		assert.Equal(t, "password", store.Password)
+	})
+
+	t.Run("wrong master key", func(t *testing.T) {
+		t.Setenv(secret.EnvMasterKey, "wrong")
+		_, err := execute("--new-key", "new")
+		assert.Error(t, err)
+	})
+}
@@ -47,6 +47,7 @@ import (
 	"github.com/linuxsuren/api-testing/pkg/mock"
 	atestoauth "github.com/linuxsuren/api-testing/pkg/oauth"
 	template "github.com/linuxsuren/api-testing/pkg/render"
+	"github.com/linuxsuren/api-testing/pkg/secret"
 	"github.com/linuxsuren/api-testing/pkg/server"
 	"github.com/linuxsuren/api-testing/pkg/service"
 	"github.com/linuxsuren/api-testing/pkg/testing"
@@ -249,6 +250,11 @@ func (o *serverOption) runE(cmd *cobra.Command, args []string) (err error) {
 		}
 	}
 
+	// the master key is used to encrypt the local secrets and the passwords of stores
+	if err = secret.InitMasterKey(o.configDir); err != nil {
+		return
+	}
+
 	var secretServer remote.SecretServiceServer
 	if o.secretServer != "" {
 		if secretServer, err = remote.NewGRPCSecretFrom(o.secretServer); err != nil {
@@ -273,6 +279,13 @@ func (o *serverOption) runE(cmd *cobra.Command, args []string) (err error) {
 		cmd.PrintErrf("error occurred during getting stores, error: %v\n", storeErr)
 	}
 
+	if scheduler, ok := remoteServer.(server.SchedulerStarter); ok {
+		if err = scheduler.StartScheduler(ctx); err != nil {
+			cmd.PrintErrf("failed to start the scheduler, error: %v\n", err)
+			err = nil
+		}
+	}
+
 	// create mock server controller
 	var mockWriter mock.ReaderAndWriter
 	if len(o.mockConfig) > 0 {
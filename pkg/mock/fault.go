@@ -0,0 +1,375 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+const (
+	// LatencyFixed always delays the same duration
+	LatencyFixed = "fixed"
+	// LatencyUniform delays a random duration between min and max
+	LatencyUniform = "uniform"
+	// LatencyNormal delays a random duration in the normal distribution
+	LatencyNormal = "normal"
+)
+
+// Fault makes the mock server misbehave on purpose, the rates are the probabilities between 0 and 1
+type Fault struct {
+	Latency *Latency    `yaml:"latency" json:"latency"`
+	Error   *ErrorFault `yaml:"error" json:"error"`
+	// ResetRate is the probability of resetting the connection
+	ResetRate float64 `yaml:"resetRate" json:"resetRate"`
+	// EmptyRate is the probability of responding without the body
+	EmptyRate float64 `yaml:"emptyRate" json:"emptyRate"`
+	// MalformedRate is the probability of responding a truncated and invalid payload
+	MalformedRate float64 `yaml:"malformedRate" json:"malformedRate"`
+	// Trickle sends the body slowly
+	Trickle *Trickle `yaml:"trickle" json:"trickle"`
+}
+
+// Latency delays the response
+type Latency struct {
+	// Distribution is the kind of the latency, it is fixed by default
+	Distribution string `yaml:"distribution" json:"distribution"`
+	// Duration is the fixed latency, or the mean of the normal distribution
+	Duration string `yaml:"duration" json:"duration"`
+	// Min and Max are the range of the uniform distribution
+	Min string `yaml:"min" json:"min"`
+	Max string `yaml:"max" json:"max"`
+	// StdDev is the standard deviation of the normal distribution
+	StdDev string `yaml:"stdDev" json:"stdDev"`
+}
+
+// ErrorFault responds an error instead of the normal response
+type ErrorFault struct {
+	Rate       float64 `yaml:"rate" json:"rate"`
+	StatusCode int     `yaml:"statusCode" json:"statusCode"`
+	Body       string  `yaml:"body" json:"body"`
+}
+
+// Trickle sends the body chunk by chunk
+type Trickle struct {
+	ChunkSize int    `yaml:"chunkSize" json:"chunkSize"`
+	Interval  string `yaml:"interval" json:"interval"`
+}
+
+type faultInjector struct {
+	fault    Fault
+	latency  func() time.Duration
+	interval time.Duration
+}
+
+// newFaultInjector parses the fault, it returns nil if there is no fault
+func newFaultInjector(fault *Fault) (injector *faultInjector, err error) {
+	if fault == nil {
+		return
+	}
+
+	for name, rate := range map[string]float64{
+		"resetRate":     fault.ResetRate,
+		"emptyRate":     fault.EmptyRate,
+		"malformedRate": fault.MalformedRate,
+	} {
+		if rate < 0 || rate > 1 {
+			err = fmt.Errorf("%s should be between 0 and 1, got %v", name, rate)
+			return
+		}
+	}
+
+	injector = &faultInjector{fault: *fault}
+	if fault.Error != nil {
+		if fault.Error.Rate < 0 || fault.Error.Rate > 1 {
+			err = fmt.Errorf("error rate should be between 0 and 1, got %v", fault.Error.Rate)
+			return
+		}
+		if code := fault.Error.StatusCode; code != 0 && (code < 100 || code > 599) {
+			err = fmt.Errorf("error statusCode should be between 100 and 599, got %d", code)
+			return
+		}
+		if fault.Error.StatusCode == 0 {
+			injector.fault.Error = &ErrorFault{
+				Rate:       fault.Error.Rate,
+				StatusCode: http.StatusInternalServerError,
+				Body:       fault.Error.Body,
+			}
+		}
+	}
+
+	if fault.Latency != nil {
+		if injector.latency, err = parseLatency(fault.Latency); err != nil {
+			return
+		}
+	}
+
+	if fault.Trickle != nil {
+		if injector.interval, err = parseDuration("trickle interval", fault.Trickle.Interval); err != nil {
+			return
+		}
+		if fault.Trickle.ChunkSize <= 0 {
+			injector.fault.Trickle = &Trickle{ChunkSize: 1, Interval: fault.Trickle.Interval}
+		}
+	}
+	return
+}
+
+func parseLatency(latency *Latency) (sample func() time.Duration, err error) {
+	var duration, minDuration, maxDuration, stdDev time.Duration
+	if duration, err = parseDuration("latency duration", latency.Duration); err != nil {
+		return
+	}
+	if minDuration, err = parseDuration("latency min", latency.Min); err != nil {
+		return
+	}
+	if maxDuration, err = parseDuration("latency max", latency.Max); err != nil {
+		return
+	}
+	if stdDev, err = parseDuration("latency stdDev", latency.StdDev); err != nil {
+		return
+	}
+
+	switch latency.Distribution {
+	case LatencyFixed, "":
+		sample = func() time.Duration {
+			return duration
+		}
+	case LatencyUniform:
+		if maxDuration < minDuration {
+			err = fmt.Errorf("latency max %v is less than min %v", maxDuration, minDuration)
+			return
+		}
+		sample = func() time.Duration {
+			return minDuration + rand.N(maxDuration-minDuration+1)
+		}
+	case LatencyNormal:
+		sample = func() time.Duration {
+			return max(0, duration+time.Duration(rand.NormFloat64()*float64(stdDev)))
+		}
+	default:
+		err = fmt.Errorf("unsupported latency distribution %q", latency.Distribution)
+	}
+	return
+}
+
+func parseDuration(name, text string) (duration time.Duration, err error) {
+	if text != "" {
+		if duration, err = time.ParseDuration(text); err != nil {
+			err = fmt.Errorf("invalid %s: %v", name, err)
+		}
+	}
+	return
+}
+
+func hit(rate float64) bool {
+	return rate > 0 && rand.Float64() < rate
+}
+
+// delay waits for the latency, it returns false if the context was done
+func (f *faultInjector) delay(ctx context.Context) bool {
+	if f == nil || f.latency == nil {
+		return true
+	}
+
+	timer := time.NewTimer(f.latency())
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// before injects the faults before responding, it returns true if the request was handled
+func (f *faultInjector) before(w http.ResponseWriter, req *http.Request) (handled bool) {
+	if f == nil {
+		return
+	}
+
+	if !f.delay(req.Context()) {
+		return true
+	}
+
+	if hit(f.fault.ResetRate) {
+		memLogger.Info("inject fault: reset connection", "path", req.URL.Path)
+		resetResponse(w)
+		return true
+	}
+
+	if f.fault.Error != nil && hit(f.fault.Error.Rate) {
+		memLogger.Info("inject fault: error response", "path", req.URL.Path, "code", f.fault.Error.StatusCode)
+		w.WriteHeader(f.fault.Error.StatusCode)
+		_, _ = w.Write([]byte(f.fault.Error.Body))
+		return true
+	}
+	return
+}
+
+// wrap returns a response writer which injects the faults into the body
+func (f *faultInjector) wrap(w http.ResponseWriter) http.ResponseWriter {
+	if f == nil {
+		return w
+	}
+	return &faultResponseWriter{
+		ResponseWriter: w,
+		injector:       f,
+		empty:          hit(f.fault.EmptyRate),
+		malformed:      hit(f.fault.MalformedRate),
+	}
+}
+
+// write sends the data chunk by chunk if trickle is enabled
+func (f *faultInjector) write(w io.Writer, data []byte) (err error) {
+	if f == nil || f.fault.Trickle == nil {
+		_, err = w.Write(data)
+		return
+	}
+
+	size := f.fault.Trickle.ChunkSize
+	for i := 0; i < len(data) && err == nil; i += size {
+		if i > 0 {
+			time.Sleep(f.interval)
+		}
+		if _, err = w.Write(data[i:min(i+size, len(data))]); err == nil {
+			if flusher, ok := w.(http.Flusher); ok {
+				flusher.Flush()
+			}
+		}
+	}
+	return
+}
+
+type faultResponseWriter struct {
+	http.ResponseWriter
+	injector    *faultInjector
+	empty       bool
+	malformed   bool
+	wroteHeader bool
+}
+
+func (w *faultResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if w.empty || w.malformed {
+		// the length of the body is changed
+		w.Header().Del(util.ContentLength)
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *faultResponseWriter) Write(data []byte) (n int, err error) {
+	w.WriteHeader(http.StatusOK)
+	n = len(data)
+	if w.empty {
+		return
+	}
+	if w.malformed {
+		data = malform(data)
+		w.malformed = false
+	}
+	err = w.injector.write(w.ResponseWriter, data)
+	return
+}
+
+func (w *faultResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// malform truncates the data and appends an invalid byte
+func malform(data []byte) []byte {
+	result := make([]byte, 0, len(data)/2+1)
+	result = append(result, data[:len(data)/2]...)
+	return append(result, 0xff)
+}
+
+// resetResponse closes the underlying connection without a response
+func resetResponse(w http.ResponseWriter) {
+	if hijacker, ok := w.(http.Hijacker); ok {
+		if conn, _, err := hijacker.Hijack(); err == nil {
+			resetConn(conn)
+			return
+		}
+	}
+	panic(http.ErrAbortHandler)
+}
+
+// resetConn closes the connection with a TCP RST instead of FIN
+func resetConn(conn net.Conn) {
+	if tcpConn, ok := conn.(*net.TCPConn); ok {
+		_ = tcpConn.SetLinger(0)
+	}
+	_ = conn.Close()
+}
+
+// acceptConn injects the faults for a new TCP connection, it returns false if the connection was closed
+func (f *faultInjector) acceptConn(ctx context.Context, conn net.Conn) bool {
+	if f == nil {
+		return true
+	}
+
+	if !f.delay(ctx) {
+		_ = conn.Close()
+		return false
+	}
+
+	if hit(f.fault.ResetRate) {
+		memLogger.Info("inject fault: reset connection", "remote", conn.RemoteAddr().String())
+		resetConn(conn)
+		return false
+	}
+
+	if hit(f.fault.EmptyRate) {
+		memLogger.Info("inject fault: empty response", "remote", conn.RemoteAddr().String())
+		_ = conn.Close()
+		return false
+	}
+	return true
+}
+
+// streamWriter returns a writer which injects the faults into the stream
+func (f *faultInjector) streamWriter(w io.Writer) io.Writer {
+	if f == nil {
+		return w
+	}
+	return &faultStreamWriter{writer: w, injector: f}
+}
+
+type faultStreamWriter struct {
+	writer   io.Writer
+	injector *faultInjector
+}
+
+func (w *faultStreamWriter) Write(data []byte) (n int, err error) {
+	n = len(data)
+	if hit(w.injector.fault.MalformedRate) {
+		data = malform(data)
+	}
+	err = w.injector.write(w.writer, data)
+	return
+}
@@ -0,0 +1,337 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFaultInjector(t *testing.T) {
+	injector, err := newFaultInjector(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, injector)
+
+	tests := []struct {
+		name     string
+		fault    Fault
+		errorMsg string
+	}{{
+		name:     "invalid rate",
+		fault:    Fault{ResetRate: 2},
+		errorMsg: "resetRate should be between 0 and 1",
+	}, {
+		name:     "invalid error rate",
+		fault:    Fault{Error: &ErrorFault{Rate: -1}},
+		errorMsg: "error rate should be between 0 and 1",
+	}, {
+		name:     "invalid error status code",
+		fault:    Fault{Error: &ErrorFault{Rate: 1, StatusCode: 42}},
+		errorMsg: "error statusCode should be between 100 and 599, got 42",
+	}, {
+		name:     "too large error status code",
+		fault:    Fault{Error: &ErrorFault{Rate: 1, StatusCode: 1000}},
+		errorMsg: "error statusCode should be between 100 and 599, got 1000",
+	}, {
+		name:     "invalid latency",
+		fault:    Fault{Latency: &Latency{Duration: "fake"}},
+		errorMsg: "invalid latency duration",
+	}, {
+		name:     "max is less than min",
+		fault:    Fault{Latency: &Latency{Distribution: LatencyUniform, Min: "2s", Max: "1s"}},
+		errorMsg: "latency max 1s is less than min 2s",
+	}, {
+		name:     "unsupported distribution",
+		fault:    Fault{Latency: &Latency{Distribution: "fake"}},
+		errorMsg: `unsupported latency distribution "fake"`,
+	}, {
+		name:     "invalid trickle interval",
+		fault:    Fault{Trickle: &Trickle{Interval: "fake"}},
+		errorMsg: "invalid trickle interval",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newFaultInjector(&tt.fault)
+			assert.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+
+	t.Run("default values", func(t *testing.T) {
+		injector, err := newFaultInjector(&Fault{
+			Error:   &ErrorFault{Rate: 1},
+			Trickle: &Trickle{Interval: "1ms"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, injector.fault.Error.StatusCode)
+		assert.Equal(t, 1, injector.fault.Trickle.ChunkSize)
+		assert.Equal(t, time.Millisecond, injector.interval)
+	})
+}
+
+func TestLatencyDistribution(t *testing.T) {
+	sample, err := parseLatency(&Latency{Duration: "10ms"})
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Millisecond, sample())
+
+	sample, err = parseLatency(&Latency{Distribution: LatencyUniform, Min: "10ms", Max: "20ms"})
+	assert.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		latency := sample()
+		assert.True(t, latency >= 10*time.Millisecond && latency <= 20*time.Millisecond, latency)
+	}
+
+	sample, err = parseLatency(&Latency{Distribution: LatencyNormal, Duration: "1ms", StdDev: "10ms"})
+	assert.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		assert.GreaterOrEqual(t, sample(), time.Duration(0))
+	}
+}
+
+const faultConfig = `fault:
+  latency:
+    duration: 50ms
+items:
+  - name: slow
+    request:
+      path: /slow
+    response:
+      body: slow
+  - name: error
+    request:
+      path: /error
+    response:
+      body: ok
+    fault:
+      error:
+        rate: 1
+        statusCode: 503
+        body: unavailable
+  - name: empty
+    request:
+      path: /empty
+    response:
+      body: '{"name":"rick"}'
+    fault:
+      emptyRate: 1
+  - name: malformed
+    request:
+      path: /malformed
+    response:
+      body: '{"name":"rick"}'
+    fault:
+      malformedRate: 1
+  - name: trickle
+    request:
+      path: /trickle
+    response:
+      body: abcd
+    fault:
+      trickle:
+        chunkSize: 2
+        interval: 30ms
+  - name: reset
+    request:
+      path: /reset
+    response:
+      body: ok
+    fault:
+      resetRate: 1
+proxies:
+  - path: /proxy
+    target: %s
+    fault:
+      error:
+        rate: 1
+        statusCode: 502`
+
+func TestFaultInjection(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("target"))
+	}))
+	defer target.Close()
+
+	server := NewInMemoryServer(context.Background(), 0)
+	handler, err := server.SetupHandler(NewInMemoryReader(fmt.Sprintf(faultConfig, target.URL)), "/mock")
+	assert.NoError(t, err)
+	mockServer := httptest.NewServer(handler)
+	defer mockServer.Close()
+
+	get := func(path string) (resp *http.Response, body string, err error) {
+		if resp, err = http.Get(mockServer.URL + "/mock" + path); err == nil {
+			var data []byte
+			data, err = io.ReadAll(resp.Body)
+			body = string(data)
+		}
+		return
+	}
+
+	t.Run("global latency", func(t *testing.T) {
+		begin := time.Now()
+		_, body, err := get("/slow")
+		assert.NoError(t, err)
+		assert.Equal(t, "slow", body)
+		assert.GreaterOrEqual(t, time.Since(begin), 50*time.Millisecond)
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		resp, body, err := get("/error")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, "unavailable", body)
+	})
+
+	t.Run("empty response", func(t *testing.T) {
+		resp, body, err := get("/empty")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Empty(t, body)
+	})
+
+	t.Run("malformed response", func(t *testing.T) {
+		_, body, err := get("/malformed")
+		assert.NoError(t, err)
+		assert.Equal(t, `{"name"`+"\xff", body)
+	})
+
+	t.Run("trickle response", func(t *testing.T) {
+		resp, err := http.Get(mockServer.URL + "/mock/trickle")
+		assert.NoError(t, err)
+		begin := time.Now()
+		data, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "abcd", string(data))
+		assert.Equal(t, "4", resp.Header.Get(util.ContentLength))
+		assert.GreaterOrEqual(t, time.Since(begin), 20*time.Millisecond)
+	})
+
+	t.Run("reset connection", func(t *testing.T) {
+		_, _, err := get("/reset")
+		assert.Error(t, err)
+	})
+
+	t.Run("proxy", func(t *testing.T) {
+		resp, _, err := get("/proxy")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	})
+
+	t.Run("invalid fault", func(t *testing.T) {
+		server := NewInMemoryServer(context.Background(), 0)
+		_, err := server.SetupHandler(NewInMemoryReader(`items:
+  - name: item
+    request:
+      path: /item
+    response:
+      body: ok
+    fault:
+      latency:
+        duration: fake`), "/mock")
+		assert.ErrorContains(t, err, `invalid fault of item "item"`)
+	})
+}
+
+func TestTCPProxyFault(t *testing.T) {
+	target, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer target.Close()
+	go func() {
+		if conn, err := target.Accept(); err == nil {
+			_, _ = conn.Write([]byte("hello"))
+			_ = conn.Close()
+		}
+	}()
+
+	fault, err := newFaultInjector(&Fault{MalformedRate: 1})
+	assert.NoError(t, err)
+
+	client, proxy := net.Pipe()
+	go handleConnection(proxy, target.Addr().String(), fault)
+
+	data, err := io.ReadAll(client)
+	assert.NoError(t, err)
+	assert.Equal(t, "he\xff", string(data))
+
+	t.Run("reset when accepting", func(t *testing.T) {
+		fault, err := newFaultInjector(&Fault{ResetRate: 1})
+		assert.NoError(t, err)
+
+		client, proxy := net.Pipe()
+		assert.False(t, fault.acceptConn(context.Background(), proxy))
+		_, err = client.Read(make([]byte, 1))
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("no fault", func(t *testing.T) {
+		var fault *faultInjector
+		_, proxy := net.Pipe()
+		assert.True(t, fault.acceptConn(context.Background(), proxy))
+	})
+
+	t.Run("the latency does not block other connections", func(t *testing.T) {
+		go func() {
+			for {
+				conn, err := target.Accept()
+				if err != nil {
+					return
+				}
+				_, _ = conn.Write([]byte("hello"))
+				_ = conn.Close()
+			}
+		}()
+
+		// only the first connection is delayed
+		var count atomic.Int32
+		fault := &faultInjector{latency: func() time.Duration {
+			if count.Add(1) == 1 {
+				return time.Minute
+			}
+			return 0
+		}}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		defer listener.Close()
+		server := &inMemoryServer{ctx: ctx}
+		go server.serveTCPProxy(listener, target.Addr().String(), fault)
+
+		slow, err := net.Dial("tcp", listener.Addr().String())
+		assert.NoError(t, err)
+		defer slow.Close()
+		assert.Eventually(t, func() bool {
+			return count.Load() == 1
+		}, time.Second, 10*time.Millisecond)
+
+		fast, err := net.Dial("tcp", listener.Addr().String())
+		assert.NoError(t, err)
+		defer fast.Close()
+		_ = fast.SetReadDeadline(time.Now().Add(5 * time.Second))
+		data, err := io.ReadAll(fast)
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+	})
+}
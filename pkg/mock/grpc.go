@@ -0,0 +1,474 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/render"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/tidwall/gjson"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// ProtocolGRPC is the protocol of the gRPC mock items
+const ProtocolGRPC = "grpc"
+
+// ProtoLoader loads all the file descriptors from the proto file, or the protoset
+type ProtoLoader func(ctx context.Context, desc atest.RPCDesc) (*protoregistry.Files, error)
+
+var protoLoader ProtoLoader
+
+// SetProtoLoader sets the proto loader of the gRPC mock items,
+// it is provided by the runner package which cannot be imported here.
+func SetProtoLoader(loader ProtoLoader) {
+	protoLoader = loader
+}
+
+// grpcMockServer serves the gRPC mock items on the same port of the HTTP mock server.
+// All the methods are handled dynamically, so the items could be reloaded.
+type grpcMockServer struct {
+	server    *grpc.Server
+	scenarios *scenarioStore
+	journal   *requestJournal
+	webhooks  *webhookTriggers
+	metrics   func() RequestMetrics
+
+	mu       sync.RWMutex
+	files    *protoregistry.Files
+	methods  map[string]*grpcMethod
+	services map[string]grpc.ServiceInfo
+}
+
+type grpcMethod struct {
+	desc  protoreflect.MethodDescriptor
+	items []*grpcItem
+}
+
+type grpcItem struct {
+	item     *Item
+	bodyJSON map[string]compiledMatcher
+	mu       sync.Mutex
+}
+
+func newGRPCMockServer(scenarios *scenarioStore, journal *requestJournal, webhooks *webhookTriggers,
+	metrics func() RequestMetrics) (s *grpcMockServer) {
+	s = &grpcMockServer{
+		scenarios: scenarios,
+		journal:   journal,
+		webhooks:  webhooks,
+		metrics:   metrics,
+		files:     &protoregistry.Files{},
+	}
+	s.server = grpc.NewServer(grpc.UnknownServiceHandler(s.handle))
+	options := reflection.ServerOptions{Services: s, DescriptorResolver: s}
+	grpc_reflection_v1.RegisterServerReflectionServer(s.server, reflection.NewServerV1(options))
+	grpc_reflection_v1alpha.RegisterServerReflectionServer(s.server, reflection.NewServer(options))
+	return
+}
+
+// load replaces all the gRPC items
+func (s *grpcMockServer) load(ctx context.Context, items []Item) (err error) {
+	files := &protoregistry.Files{}
+	methods := map[string]*grpcMethod{}
+	services := map[string]grpc.ServiceInfo{}
+	loaded := map[string]*protoregistry.Files{}
+
+	if len(items) > 0 && protoLoader == nil {
+		err = errors.New("the proto loader is required by the gRPC mock items")
+		return
+	}
+
+	for i := range items {
+		item := &items[i]
+		if item.Request.RPC == nil {
+			err = fmt.Errorf("the proto of gRPC item %q is required", item.Name)
+			return
+		}
+
+		desc := atest.RPCDesc{
+			ProtoFile:  item.Request.RPC.ProtoFile,
+			ProtoSet:   item.Request.RPC.ProtoSet,
+			ImportPath: item.Request.RPC.ImportPath,
+		}
+		key := fmt.Sprintf("%s|%s|%s", desc.ProtoFile, desc.ProtoSet, strings.Join(desc.ImportPath, ","))
+		itemFiles, ok := loaded[key]
+		if !ok {
+			if itemFiles, err = protoLoader(ctx, desc); err != nil {
+				err = fmt.Errorf("failed to load the proto of gRPC item %q: %v", item.Name, err)
+				return
+			}
+			loaded[key] = itemFiles
+			if err = mergeProtoFiles(files, itemFiles); err != nil {
+				return
+			}
+		}
+
+		var method protoreflect.MethodDescriptor
+		if method, err = findMethod(itemFiles, item.Request.Path); err != nil {
+			err = fmt.Errorf("invalid gRPC item %q: %v", item.Name, err)
+			return
+		}
+
+		grpcItem := &grpcItem{item: item}
+		if grpcItem.bodyJSON, err = compileMatchers("body JSON path", item.Request.BodyJSON); err != nil {
+			return
+		}
+		if err = validateGRPCStatus(item); err != nil {
+			return
+		}
+
+		fullMethod := fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name())
+		if methods[fullMethod] == nil {
+			methods[fullMethod] = &grpcMethod{desc: method}
+		}
+		methods[fullMethod].items = append(methods[fullMethod].items, grpcItem)
+
+		service := string(method.Parent().FullName())
+		info := services[service]
+		info.Methods = append(info.Methods, grpc.MethodInfo{
+			Name:           string(method.Name()),
+			IsClientStream: method.IsStreamingClient(),
+			IsServerStream: method.IsStreamingServer(),
+		})
+		services[service] = info
+		memLogger.Info("register gRPC mock service", "name", item.Name, "method", fullMethod)
+	}
+	for _, method := range methods {
+		sortByPriority(method.items, func(item *grpcItem) (int, bool) {
+			return item.item.Priority, item.item.Fallback
+		})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files, s.methods, s.services = files, methods, services
+	return
+}
+
+func mergeProtoFiles(target, source *protoregistry.Files) (err error) {
+	source.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		if _, findErr := target.FindFileByPath(fd.Path()); findErr == nil {
+			return true
+		}
+		err = target.RegisterFile(fd)
+		return err == nil
+	})
+	return
+}
+
+// findMethod supports the method names like: /pkg.Service/Method, pkg.Service/Method, pkg.Service.Method
+func findMethod(files *protoregistry.Files, path string) (method protoreflect.MethodDescriptor, err error) {
+	name := strings.TrimPrefix(path, "/")
+	if index := strings.LastIndex(name, "/"); index > 0 {
+		name = name[:index] + "." + name[index+1:]
+	}
+
+	var desc protoreflect.Descriptor
+	if desc, err = files.FindDescriptorByName(protoreflect.FullName(name)); err != nil {
+		err = fmt.Errorf("cannot find the method %q: %v", path, err)
+		return
+	}
+
+	var ok bool
+	if method, ok = desc.(protoreflect.MethodDescriptor); !ok {
+		err = fmt.Errorf("%q is not a method", path)
+	}
+	return
+}
+
+func validateGRPCStatus(item *Item) (err error) {
+	responses := append([]Response{item.Response}, item.Responses...)
+	for _, response := range responses {
+		if _, err = response.GRPCStatus.code(); err != nil {
+			err = fmt.Errorf("invalid gRPC status of item %q: %v", item.Name, err)
+			return
+		}
+	}
+	return
+}
+
+// code parses the status code from its name or number
+func (s *GRPCStatus) code() (code codes.Code, err error) {
+	if s == nil || s.Code == "" {
+		return
+	}
+
+	name := strings.ToUpper(s.Code)
+	if _, numErr := strconv.Atoi(name); numErr != nil {
+		name = strconv.Quote(name)
+	}
+	err = code.UnmarshalJSON([]byte(name))
+	return
+}
+
+// GetServiceInfo implements reflection.ServiceInfoProvider
+func (s *grpcMockServer) GetServiceInfo() map[string]grpc.ServiceInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.services
+}
+
+// FindFileByPath implements protodesc.Resolver
+func (s *grpcMockServer) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.files.FindFileByPath(path)
+}
+
+// FindDescriptorByName implements protodesc.Resolver
+func (s *grpcMockServer) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.files.FindDescriptorByName(name)
+}
+
+// handler serves the gRPC requests, and passes others to the next handler
+func (s *grpcMockServer) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.ProtoMajor == 2 && strings.HasPrefix(req.Header.Get(util.ContentType), "application/grpc") {
+			s.server.ServeHTTP(w, req)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+func (s *grpcMockServer) handle(_ any, stream grpc.ServerStream) (err error) {
+	fullMethod, _ := grpc.MethodFromServerStream(stream)
+	s.metrics().RecordRequest(fullMethod)
+
+	entry := JournalEntry{
+		Time:   time.Now(),
+		Method: strings.ToUpper(ProtocolGRPC),
+		Path:   fullMethod,
+	}
+	if md, ok := metadata.FromIncomingContext(stream.Context()); ok {
+		entry.Header = make(map[string]string, len(md))
+		for k, v := range md {
+			entry.Header[k] = strings.Join(v, ",")
+		}
+	}
+	defer func() {
+		entry.Status = int(status.Code(err))
+		s.journal.record(entry)
+	}()
+
+	s.mu.RLock()
+	method := s.methods[fullMethod]
+	s.mu.RUnlock()
+	if method == nil {
+		err = status.Errorf(codes.Unimplemented, "no gRPC mock item of method %s", fullMethod)
+		return
+	}
+
+	var payload string
+	if payload, err = receiveMessages(stream, method.desc); err != nil {
+		return
+	}
+	entry.Body = payload
+	if len(entry.Body) > maxJournalBodySize {
+		entry.Body = entry.Body[:maxJournalBodySize]
+	}
+
+	var item *grpcItem
+	if item = method.selectItem(s.scenarios, payload); item == nil {
+		err = status.Errorf(codes.NotFound, "no gRPC mock item matched the request of method %s", fullMethod)
+		return
+	}
+	entry.Item = item.item.Name
+	memLogger.Info("receiving gRPC mock request", "name", item.item.Name, "method", fullMethod)
+	err = item.respond(stream, method.desc, s.scenarios, payload)
+	s.webhooks.trigger(item.item.Name, map[string]interface{}{
+		"_payload": payload,
+	})
+	return
+}
+
+// receiveMessages reads the request messages as JSON, it is an array for the client streaming
+func receiveMessages(stream grpc.ServerStream, md protoreflect.MethodDescriptor) (payload string, err error) {
+	var messages []json.RawMessage
+	for {
+		message := dynamicpb.NewMessage(md.Input())
+		if err = stream.RecvMsg(message); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+				break
+			}
+			return
+		}
+
+		var data []byte
+		if data, err = protojson.Marshal(message); err != nil {
+			err = status.Errorf(codes.Internal, "failed to marshal the request: %v", err)
+			return
+		}
+		messages = append(messages, data)
+		if !md.IsStreamingClient() {
+			break
+		}
+	}
+
+	if !md.IsStreamingClient() {
+		if len(messages) > 0 {
+			payload = string(messages[0])
+		}
+		return
+	}
+
+	if messages == nil {
+		messages = []json.RawMessage{}
+	}
+	var data []byte
+	data, err = json.Marshal(messages)
+	payload = string(data)
+	return
+}
+
+// selectItem returns the first item which matches the request, the items are sorted by the priority
+func (m *grpcMethod) selectItem(scenarios *scenarioStore, payload string) *grpcItem {
+	for _, item := range m.items {
+		if scenarios.match(item.item.Name) != "" {
+			continue
+		}
+
+		matched := true
+		for path, matcher := range item.bodyJSON {
+			result := gjson.Get(payload, path)
+			if matcher.match(result.String(), result.Exists()) != "" {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return item
+		}
+	}
+	return nil
+}
+
+func (g *grpcItem) respond(stream grpc.ServerStream, md protoreflect.MethodDescriptor, scenarios *scenarioStore, payload string) (err error) {
+	// the item is copied, a slow client does not block the other calls while the messages are sent
+	g.mu.Lock()
+	copied := *g.item
+	g.mu.Unlock()
+
+	item := &copied
+	item.Param = map[string]interface{}{"_payload": payload}
+	response := item.Response
+	index, reason := scenarios.matchAndNext(item)
+	if reason != "" {
+		// the state was changed by another request after the item was selected
+		err = status.Errorf(codes.NotFound, "gRPC mock item %s does not match the request: %s", item.Name, reason)
+		return
+	}
+	if len(item.Responses) > 0 {
+		response = item.Responses[index]
+	}
+
+	if err = stream.SetHeader(renderMetadata(response.Header, item)); err != nil {
+		return
+	}
+	stream.SetTrailer(renderMetadata(response.Trailer, item))
+
+	code, _ := response.GRPCStatus.code()
+	if code == codes.OK || md.IsStreamingServer() {
+		var body []byte
+		if body, err = render.RenderAsBytes("grpc mock response", response.Body, item); err != nil {
+			err = status.Errorf(codes.Internal, "failed to render the response: %v", err)
+			return
+		}
+
+		var messages []*dynamicpb.Message
+		if messages, err = toResponseMessages(md, body); err != nil {
+			err = status.Errorf(codes.Internal, "invalid response of item %q: %v", item.Name, err)
+			return
+		}
+		for _, message := range messages {
+			if err = stream.SendMsg(message); err != nil {
+				return
+			}
+		}
+	}
+
+	if code != codes.OK {
+		err = status.Error(code, response.GRPCStatus.Message)
+	}
+	return
+}
+
+func renderMetadata(data map[string]string, item *Item) (md metadata.MD) {
+	md = metadata.MD{}
+	for k, v := range data {
+		if value, err := render.Render("grpc mock metadata", v, item); err == nil {
+			v = value
+		} else {
+			memLogger.Error(err, "failed to render the metadata", "key", k)
+		}
+		md.Append(k, v)
+	}
+	return
+}
+
+// toResponseMessages converts the JSON to messages, a JSON array means multiple messages of server streaming
+func toResponseMessages(md protoreflect.MethodDescriptor, body []byte) (messages []*dynamicpb.Message, err error) {
+	body = []byte(strings.TrimSpace(string(body)))
+	if len(body) == 0 {
+		body = []byte("{}")
+	}
+
+	var items []json.RawMessage
+	if md.IsStreamingServer() && body[0] == '[' {
+		if err = json.Unmarshal(body, &items); err != nil {
+			return
+		}
+	} else {
+		items = []json.RawMessage{body}
+	}
+
+	for _, data := range items {
+		message := dynamicpb.NewMessage(md.Output())
+		if err = protojson.Unmarshal(data, message); err != nil {
+			return
+		}
+		messages = append(messages, message)
+	}
+	return
+}
+
+func (s *grpcMockServer) stop() {
+	s.server.Stop()
+}
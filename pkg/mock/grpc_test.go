@@ -0,0 +1,292 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/runner"
+	testsrv "github.com/linuxsuren/api-testing/pkg/runner/grpc_test"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const grpcMockConfig = `items:
+  - name: unary-fallback
+    fallback: true
+    request:
+      protocol: grpc
+      path: /grpctest.Main/Unary
+      rpc:
+        protofile: ../runner/grpc_test/test.proto
+    response:
+      body: |
+        {"message": "Fallback!"}
+  - name: unary
+    request:
+      protocol: grpc
+      path: /grpctest.Main/Unary
+      rpc:
+        protofile: ../runner/grpc_test/test.proto
+    response:
+      header:
+        x-mock: unary
+      trailer:
+        x-trailer: done
+      body: |
+        {"message": "Hello!"}
+  - name: basic
+    request:
+      protocol: grpc
+      path: grpctest.Main/TestBasicType
+      rpc:
+        protofile: ../runner/grpc_test/test.proto
+    response:
+      body: |
+        {"String": "{{(fromJson .Param._payload).String}}", "Int32": 1}
+  - name: basic-not-found
+    priority: 1
+    request:
+      protocol: grpc
+      path: grpctest.Main.TestBasicType
+      rpc:
+        protofile: ../runner/grpc_test/test.proto
+      bodyJSON:
+        String: missing
+    response:
+      grpcStatus:
+        code: NOT_FOUND
+        message: no such data
+  - name: server-stream
+    request:
+      protocol: grpc
+      path: /grpctest.Main/ServerStream
+      rpc:
+        protofile: ../runner/grpc_test/test.proto
+    response:
+      body: |
+        [{"MsgID": 1}, {"MsgID": 2}]
+      grpcStatus:
+        code: 14
+  - name: client-stream
+    request:
+      protocol: grpc
+      path: /grpctest.Main/ClientStream
+      rpc:
+        protofile: ../runner/grpc_test/test.proto
+    response:
+      body: |
+        {"data": {{.Param._payload}}}
+  - name: http
+    request:
+      path: /http
+    response:
+      body: ok`
+
+func init() {
+	SetProtoLoader(runner.LoadProtoDescriptors)
+}
+
+func TestGRPCMockServer(t *testing.T) {
+	server := NewInMemoryServer(context.Background(), 0)
+	err := server.Start(NewInMemoryReader(grpcMockConfig), "/mock")
+	assert.NoError(t, err)
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("localhost:"+server.GetPort(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer conn.Close()
+	client := testsrv.NewMainClient(conn)
+	ctx := context.Background()
+
+	// the fallback item is defined before the regular one
+	t.Run("unary with header and trailer", func(t *testing.T) {
+		var header, trailer metadata.MD
+		reply, err := client.Unary(ctx, &testsrv.Empty{}, grpc.Header(&header), grpc.Trailer(&trailer))
+		assert.NoError(t, err)
+		assert.Equal(t, "Hello!", reply.GetMessage())
+		assert.Equal(t, []string{"unary"}, header.Get("x-mock"))
+		assert.Equal(t, []string{"done"}, trailer.Get("x-trailer"))
+	})
+
+	// the item with higher priority is defined after the one without body matcher
+	t.Run("match the request body", func(t *testing.T) {
+		reply, err := client.TestBasicType(ctx, &testsrv.BasicType{String_: "rick"})
+		assert.NoError(t, err)
+		assert.Equal(t, "rick", reply.GetString_())
+		assert.Equal(t, int32(1), reply.GetInt32())
+
+		_, err = client.TestBasicType(ctx, &testsrv.BasicType{String_: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, "no such data", status.Convert(err).Message())
+	})
+
+	t.Run("server stream with status", func(t *testing.T) {
+		stream, err := client.ServerStream(ctx, &testsrv.StreamMessageRepeated{})
+		assert.NoError(t, err)
+
+		var ids []int32
+		for {
+			msg, recvErr := stream.Recv()
+			if recvErr != nil {
+				err = recvErr
+				break
+			}
+			ids = append(ids, msg.GetMsgID())
+		}
+		assert.Equal(t, []int32{1, 2}, ids)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("client stream", func(t *testing.T) {
+		stream, err := client.ClientStream(ctx)
+		assert.NoError(t, err)
+		for i := int32(1); i <= 3; i++ {
+			assert.NoError(t, stream.Send(&testsrv.StreamMessage{MsgID: i}))
+		}
+		reply, err := stream.CloseAndRecv()
+		assert.NoError(t, err)
+		assert.Len(t, reply.GetData(), 3)
+	})
+
+	t.Run("not implemented", func(t *testing.T) {
+		_, err := client.TestAdvancedType(ctx, &testsrv.AdvancedType{})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("call via the reflection", func(t *testing.T) {
+		grpcRunner := runner.NewGRPCTestCaseRunner("localhost:"+server.GetPort(), atest.RPCDesc{ServerReflection: true})
+		output, err := grpcRunner.RunTestCase(&atest.TestCase{
+			Request: atest.Request{
+				API:  "localhost:" + server.GetPort() + "/grpctest.Main/Unary",
+				Body: atest.NewRequestBody("{}"),
+			},
+			Expect: atest.Response{
+				Body: `{"message": "Hello!"}`,
+			},
+		}, nil, ctx)
+		assert.NoError(t, err)
+		assert.NotNil(t, output)
+	})
+
+	t.Run("journal", func(t *testing.T) {
+		verification, err := server.(JournalReader).VerifyJournal(JournalFilter{Item: "basic", Method: "GRPC"}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, verification.Count)
+	})
+
+	t.Run("HTTP items are still served", func(t *testing.T) {
+		resp, err := httpGet("http://localhost:" + server.GetPort() + "/mock/http")
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+}
+
+func TestGRPCMockServerSlowClient(t *testing.T) {
+	server := NewInMemoryServer(context.Background(), 0)
+	err := server.Start(NewInMemoryReader(`items:
+  - name: server-stream
+    request:
+      protocol: grpc
+      path: /grpctest.Main/ServerStream
+      rpc:
+        protofile: ../runner/grpc_test/test.proto
+    response:
+      body: |
+        [{{range $i, $e := until 20000}}{{if $i}},{{end}}{"MsgID": {{$i}}, "ExpectLen": 1000000}{{end}}]`), "/mock")
+	assert.NoError(t, err)
+	defer server.Stop()
+
+	// the small window blocks the sending since the client does not receive the messages
+	slowConn, err := grpc.NewClient("localhost:"+server.GetPort(), grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithInitialWindowSize(64*1024), grpc.WithInitialConnWindowSize(64*1024))
+	assert.NoError(t, err)
+	defer slowConn.Close()
+	slowCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err = testsrv.NewMainClient(slowConn).ServerStream(slowCtx, &testsrv.StreamMessageRepeated{})
+	assert.NoError(t, err)
+
+	conn, err := grpc.NewClient("localhost:"+server.GetPort(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer conn.Close()
+	ctx, cancelCall := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelCall()
+	stream, err := testsrv.NewMainClient(conn).ServerStream(ctx, &testsrv.StreamMessageRepeated{})
+	assert.NoError(t, err)
+
+	count := 0
+	for {
+		if _, err = stream.Recv(); err != nil {
+			break
+		}
+		count++
+	}
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, 20000, count)
+}
+
+func TestGRPCMockServerInvalid(t *testing.T) {
+	for name, config := range map[string]string{
+		"missing proto": `items:
+  - name: unary
+    request:
+      protocol: grpc
+      path: /grpctest.Main/Unary`,
+		"unknown method": `items:
+  - name: unary
+    request:
+      protocol: grpc
+      path: /grpctest.Main/Fake
+      rpc:
+        protofile: ../runner/grpc_test/test.proto`,
+		"invalid status": `items:
+  - name: unary
+    request:
+      protocol: grpc
+      path: /grpctest.Main/Unary
+      rpc:
+        protofile: ../runner/grpc_test/test.proto
+    response:
+      grpcStatus:
+        code: fake`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := NewInMemoryServer(context.Background(), 0)
+			_, err := server.SetupHandler(NewInMemoryReader(config), "/mock")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func httpGet(api string) (body string, err error) {
+	var resp *http.Response
+	if resp, err = http.Get(api); err == nil {
+		var data []byte
+		data, err = io.ReadAll(resp.Body)
+		body = string(data)
+	}
+	return
+}
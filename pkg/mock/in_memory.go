@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -48,7 +49,7 @@ var (
 )
 
 type inMemoryServer struct {
-	data              map[string][]map[string]interface{}
+	objects           *objectStore
 	mux               *mux.Router
 	listener          net.Listener
 	certFile, keyFile string
@@ -59,27 +60,44 @@ type inMemoryServer struct {
 	cancelFunc        context.CancelFunc
 	reader            Reader
 	metrics           RequestMetrics
+	scenarios         *scenarioStore
+	matchers          *requestMatchers
+	journal           *requestJournal
+	webhooks          *webhookTriggers
+	grpc              *grpcMockServer
 }
 
 func NewInMemoryServer(ctx context.Context, port int) DynamicServer {
 	ctx, cancel := context.WithCancel(ctx)
-	return &inMemoryServer{
+	scenarios := newScenarioStore()
+	server := &inMemoryServer{
 		port:       port,
 		wg:         sync.WaitGroup{},
 		ctx:        ctx,
 		cancelFunc: cancel,
 		metrics:    NewNoopMetrics(),
+		scenarios:  scenarios,
+		matchers:   newRequestMatchers(scenarios),
+		journal:    newRequestJournal(defaultJournalSize),
 	}
+	server.webhooks = newWebhookTriggers(ctx, &server.wg, server.journal)
+	server.grpc = newGRPCMockServer(scenarios, server.journal, server.webhooks, func() RequestMetrics {
+		return server.metrics
+	})
+	return server
 }
 
 func (s *inMemoryServer) SetupHandler(reader Reader, prefix string) (handler http.Handler, err error) {
 	s.reader = reader
 	// init the data
-	s.data = make(map[string][]map[string]interface{})
+	s.objects = newObjectStore()
 	s.mux = mux.NewRouter().PathPrefix(prefix).Subrouter()
+	s.mux.NotFoundHandler = http.HandlerFunc(s.notFoundHandler)
 	s.prefix = prefix
-	handler = s.mux
+	handler = s.grpc.handler(s.matchHandler(s.mux))
 	s.metrics.AddMetricsHandler(s.mux)
+	s.scenarios.addScenarioHandler(s.mux)
+	s.addJournalHandler()
 	err = s.Load()
 	return
 }
@@ -107,16 +125,92 @@ func (s *inMemoryServer) Load() (err error) {
 		return
 	}
 
+	var recordedItems []Item
+	if recordedItems, err = loadRecordedItems(server.Proxies); err != nil {
+		err = fmt.Errorf("failed to load the recorded items: %v", err)
+		return
+	}
+	server.Items = append(server.Items, recordedItems...)
+
+	if err = s.scenarios.load(server.Scenarios, server.Items); err != nil {
+		return
+	}
+	if err = s.webhooks.load(server.Webhooks, server.Items); err != nil {
+		return
+	}
+
+	// the gRPC items are served by the gRPC mock server
+	var httpItems, grpcItems []Item
+	for _, item := range server.Items {
+		if item.Request.Protocol == ProtocolGRPC {
+			grpcItems = append(grpcItems, item)
+		} else {
+			httpItems = append(httpItems, item)
+		}
+	}
+	if err = s.grpc.load(s.ctx, grpcItems); err != nil {
+		return
+	}
+	server.Items = httpItems
+
+	if err = s.matchers.load(server.Items, s.prefix); err != nil {
+		return
+	}
+
+	var globalFault *faultInjector
+	if globalFault, err = newFaultInjector(server.Fault); err != nil {
+		err = fmt.Errorf("invalid global fault: %v", err)
+		return
+	}
+	itemFaults := make([]*faultInjector, len(server.Items))
+	for i, item := range server.Items {
+		if itemFaults[i], err = getFaultInjector(item.Fault, globalFault); err != nil {
+			err = fmt.Errorf("invalid fault of item %q: %v", item.Name, err)
+			return
+		}
+	}
+	proxyFaults := make([]*faultInjector, len(server.Proxies))
+	proxyRecorders := make([]*proxyRecorder, len(server.Proxies))
+	for i, proxy := range server.Proxies {
+		if proxyFaults[i], err = getFaultInjector(proxy.Fault, globalFault); err != nil {
+			err = fmt.Errorf("invalid fault of proxy %q: %v", proxy.Path, err)
+			return
+		}
+		switch proxy.Mode {
+		case "", ProxyModeReplay:
+		case ProxyModeRecord:
+			if proxyRecorders[i], err = newProxyRecorder(&proxy); err != nil {
+				return
+			}
+		default:
+			err = fmt.Errorf("unsupported mode %q of proxy %q", proxy.Mode, proxy.Path)
+			return
+		}
+	}
+
 	memLogger.Info("start to run all the APIs from objects", "count", len(server.Objects))
 	for _, obj := range server.Objects {
 		memLogger.Info("start mock server from object", "name", obj.Name)
+		if err = s.objects.load(obj); err != nil {
+			return
+		}
 		s.startObject(obj)
-		s.initObjectData(obj)
 	}
 
 	memLogger.Info("start to run all the APIs from items", "count", len(server.Items))
-	for _, item := range server.Items {
-		s.startItem(item)
+	for i, item := range server.Items {
+		s.startItem(item, itemFaults[i])
+	}
+
+	if server.OpenAPI != nil {
+		if err = s.startOpenAPI(server.OpenAPI); err != nil {
+			return
+		}
+	}
+	if server.OIDC != nil {
+		if err = s.startOIDC(server.OIDC); err != nil {
+			return
+		}
 	}
 
 	memLogger.Info("start webhook servers", "count", len(server.Webhooks))
@@ -129,12 +223,16 @@ func (s *inMemoryServer) Load() (err error) {
 	s.handleOpenAPI()
 
 	for i, proxy := range server.Proxies {
+		if proxy.Mode == ProxyModeReplay {
+			// the recorded items are served instead
+			continue
+		}
 		memLogger.Info("start to proxy", "target", proxy.Target)
 		switch proxy.Protocol {
 		case "http", "":
-			s.httpProxy(&proxy)
+			s.httpProxy(&proxy, proxyFaults[i], proxyRecorders[i])
 		case "tcp":
-			s.tcpProxy(&server.Proxies[i])
+			s.tcpProxy(&server.Proxies[i], proxyFaults[i])
 		default:
 			memLogger.Error(fmt.Errorf("unsupported protocol: %s", proxy.Protocol), "failed to start proxy")
 		}
@@ -142,8 +240,21 @@ func (s *inMemoryServer) Load() (err error) {
 	return
 }
 
-func (s *inMemoryServer) httpProxy(proxy *Proxy) {
+// getFaultInjector returns the global one if the fault is not set
+func getFaultInjector(fault *Fault, global *faultInjector) (*faultInjector, error) {
+	if fault == nil {
+		return global, nil
+	}
+	return newFaultInjector(fault)
+}
+
+func (s *inMemoryServer) httpProxy(proxy *Proxy, fault *faultInjector, recorder *proxyRecorder) {
 	s.mux.HandleFunc(proxy.Path, func(w http.ResponseWriter, req *http.Request) {
+		if fault.before(w, req) {
+			return
+		}
+		w = fault.wrap(w)
+
 		if !strings.HasSuffix(proxy.Target, "/") {
 			proxy.Target += "/"
 		}
@@ -151,6 +262,9 @@ func (s *inMemoryServer) httpProxy(proxy *Proxy) {
 		targetPath = strings.TrimPrefix(targetPath, "/")
 
 		apiRaw := fmt.Sprintf("%s%s", proxy.Target, targetPath)
+		if req.URL.RawQuery != "" {
+			apiRaw = fmt.Sprintf("%s?%s", apiRaw, req.URL.RawQuery)
+		}
 		api, err := render.Render("proxy api", apiRaw, s)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -222,11 +336,27 @@ func (s *inMemoryServer) httpProxy(proxy *Proxy) {
 			fmt.Println(string(data))
 		}
 
+		if recorder != nil {
+			if err := recorder.save(&recordedRequest{
+				method:       req.Method,
+				path:         "/" + targetPath,
+				query:        req.URL.Query(),
+				header:       req.Header,
+				body:         requestBody,
+				statusCode:   resp.StatusCode,
+				respHeader:   resp.Header,
+				responseBody: data,
+			}); err != nil {
+				memLogger.Error(err, "failed to record the proxy traffic", "file", recorder.record.File)
+			}
+		}
+
+		w.WriteHeader(resp.StatusCode)
 		w.Write(data)
 	})
 }
 
-func (s *inMemoryServer) tcpProxy(proxy *Proxy) {
+func (s *inMemoryServer) tcpProxy(proxy *Proxy, fault *faultInjector) {
 	fmt.Println("start to proxy", proxy.Port)
 	lisener, err := net.Listen("tcp", fmt.Sprintf(":%d", proxy.Port))
 	if err != nil {
@@ -235,20 +365,32 @@ func (s *inMemoryServer) tcpProxy(proxy *Proxy) {
 	}
 	fmt.Printf("proxy local: %d, target: %s\n", proxy.Port, proxy.Target)
 	defer lisener.Close()
+	s.serveTCPProxy(lisener, proxy.Target, fault)
+}
 
+// serveTCPProxy accepts the connections until the listener is closed
+func (s *inMemoryServer) serveTCPProxy(listener net.Listener, target string, fault *faultInjector) {
 	for {
-		conn, err := lisener.Accept()
+		conn, err := listener.Accept()
 		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
 			memLogger.Error(err, "failed to accept")
 			continue
 		}
 
 		fmt.Println("accept connection")
-		go handleConnection(conn, proxy.Target)
+		// the faults are injected in the goroutine, the latency of one connection should not block others
+		go func() {
+			if fault.acceptConn(s.ctx, conn) {
+				handleConnection(conn, target, fault)
+			}
+		}()
 	}
 }
 
-func handleConnection(clientConn net.Conn, targetAddr string) {
+func handleConnection(clientConn net.Conn, targetAddr string, fault *faultInjector) {
 	defer clientConn.Close()
 
 	targetConn, err := net.DialTimeout("tcp", targetAddr, 10*time.Second)
@@ -260,10 +402,17 @@ func handleConnection(clientConn net.Conn, targetAddr string) {
 
 	fmt.Printf("Connection established between %s and %s\n", clientConn.RemoteAddr(), targetConn.RemoteAddr())
 
-	go io.Copy(clientConn, targetConn)
-	go io.Copy(targetConn, clientConn)
-
-	select {}
+	// close both connections once any side is done
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(fault.streamWriter(clientConn), targetConn)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(targetConn, clientConn)
+		done <- struct{}{}
+	}()
+	<-done
 }
 
 func (s *inMemoryServer) Start(reader Reader, prefix string) (err error) {
@@ -277,7 +426,11 @@ func (s *inMemoryServer) Start(reader Reader, prefix string) (err error) {
 					}
 				} else {
 					memLogger.Info("start HTTP mock server")
-					err = http.Serve(s.listener, handler)
+					// the unencrypted HTTP/2 is required by the gRPC mock items
+					server := &http.Server{Handler: handler, Protocols: &http.Protocols{}}
+					server.Protocols.SetHTTP1(true)
+					server.Protocols.SetUnencryptedHTTP2(true)
+					err = server.Serve(s.listener)
 				}
 			}()
 		}
@@ -289,165 +442,62 @@ func (s *inMemoryServer) EnableMetrics() {
 	s.metrics = NewInMemoryMetrics()
 }
 
-func (s *inMemoryServer) startObject(obj Object) {
-	// create a simple CRUD server
-	s.mux.HandleFunc("/"+obj.Name, func(w http.ResponseWriter, req *http.Request) {
-		memLogger.Info("mock server received request", "path", req.URL.Path)
-		s.metrics.RecordRequest(req.URL.Path)
-		method := req.Method
-		w.Header().Set(util.ContentType, util.JSON)
-
-		switch method {
-		case http.MethodGet:
-			// list all items
-			allItems := s.data[obj.Name]
-			filteredItems := make([]map[string]interface{}, 0)
-
-			for i, item := range allItems {
-				exclude := false
-
-				for k, v := range req.URL.Query() {
-					if len(v) == 0 {
-						continue
-					}
-
-					if val, ok := item[k]; ok && val != v[0] {
-						exclude = true
-						break
-					}
-				}
-
-				if !exclude {
-					filteredItems = append(filteredItems, allItems[i])
-				}
-			}
-
-			if len(filteredItems) != len(allItems) {
-				allItems = filteredItems
-			}
-
-			data, err := json.Marshal(allItems)
-			writeResponse(w, data, err)
-		case http.MethodPost:
-			// create an item
-			if data, err := io.ReadAll(req.Body); err == nil {
-				objData := map[string]interface{}{}
-
-				jsonErr := json.Unmarshal(data, &objData)
-				if jsonErr != nil {
-					memLogger.Info(jsonErr.Error())
-					return
-				}
-
-				s.data[obj.Name] = append(s.data[obj.Name], objData)
-
-				_, _ = w.Write(data)
-			} else {
-				memLogger.Info("failed to read from body", "error", err)
-			}
-		default:
-			w.WriteHeader(http.StatusMethodNotAllowed)
-		}
-	})
-
-	// handle a single object
-	s.mux.HandleFunc(fmt.Sprintf("/%s/{name}", obj.Name), func(w http.ResponseWriter, req *http.Request) {
-		s.metrics.RecordRequest(req.URL.Path)
-		w.Header().Set(util.ContentType, util.JSON)
-		objects := s.data[obj.Name]
-		if objects != nil {
-			name := mux.Vars(req)["name"]
-			var data []byte
-			for _, obj := range objects {
-				if obj["name"] == name {
-
-					data, _ = json.Marshal(obj)
-					break
-				}
-			}
-
-			if len(data) == 0 {
-				w.WriteHeader(http.StatusNotFound)
-				return
-			}
-
-			method := req.Method
-			switch method {
-			case http.MethodGet:
-				writeResponse(w, data, nil)
-			case http.MethodPut:
-				objData := map[string]interface{}{}
-				if data, err := io.ReadAll(req.Body); err == nil {
-
-					jsonErr := json.Unmarshal(data, &objData)
-					if jsonErr != nil {
-						memLogger.Info(jsonErr.Error())
-						return
-					}
-					for i, item := range s.data[obj.Name] {
-						if item["name"] == name {
-							s.data[obj.Name][i] = objData
-							break
-						}
-					}
-					_, _ = w.Write(data)
-				}
-			case http.MethodDelete:
-				for i, item := range s.data[obj.Name] {
-					if item["name"] == name {
-						if len(s.data[obj.Name]) == i+1 {
-							s.data[obj.Name] = s.data[obj.Name][:i]
-						} else {
-							s.data[obj.Name] = append(s.data[obj.Name][:i], s.data[obj.Name][i+1])
-						}
-
-						writeResponse(w, []byte(`{"msg": "deleted"}`), nil)
-					}
-				}
-			default:
-				w.WriteHeader(http.StatusMethodNotAllowed)
-			}
-
-		}
-	})
-}
-
-func (s *inMemoryServer) startItem(item Item) {
+func (s *inMemoryServer) startItem(item Item, fault *faultInjector) {
 	method := util.EmptyThenDefault(item.Request.Method, http.MethodGet)
 	memLogger.Info("register mock service", "method", method, "path", item.Request.Path, "encoder", item.Response.Encoder)
 
-	var headerSlices []string
-	for k, v := range item.Request.Header {
-		headerSlices = append(headerSlices, k, v)
-	}
-
 	adHandler := &advanceHandler{
-		item:    &item,
-		metrics: s.metrics,
-		mu:      sync.Mutex{},
+		item:      &item,
+		metrics:   s.metrics,
+		scenarios: s.scenarios,
+		webhooks:  s.webhooks,
+		fault:     fault,
+		mu:        sync.Mutex{},
 	}
 	existedRoute := s.mux.GetRoute(item.Name)
 	if existedRoute == nil {
-		s.mux.NewRoute().Name(item.Name).Methods(strings.Split(method, ",")...).Headers(headerSlices...).Path(item.Request.Path).HandlerFunc(adHandler.handle)
+		// the header, query, body and scenario state are checked by the route matcher
+		s.mux.NewRoute().Name(item.Name).Methods(strings.Split(method, ",")...).Path(item.Request.Path).
+			MatcherFunc(s.routeMatcher(item.Name)).HandlerFunc(adHandler.handle)
 	} else {
 		existedRoute.HandlerFunc(adHandler.handle)
 	}
 }
 
 type advanceHandler struct {
-	item    *Item
-	metrics RequestMetrics
-	mu      sync.Mutex
+	item      *Item
+	metrics   RequestMetrics
+	scenarios *scenarioStore
+	webhooks  *webhookTriggers
+	fault     *faultInjector
+	mu        sync.Mutex
 }
 
 func (h *advanceHandler) handle(w http.ResponseWriter, req *http.Request) {
+	h.metrics.RecordRequest(req.URL.Path)
+	// inject the faults without the lock, the latency should not block other requests
+	if h.fault.before(w, req) {
+		return
+	}
+	w = h.fault.wrap(w)
+
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	h.metrics.RecordRequest(req.URL.Path)
 	memLogger.Info("receiving mock request", "name", h.item.Name, "method", req.Method, "path", req.URL.Path,
 		"encoder", h.item.Response.Encoder)
 
+	index, reason := h.scenarios.matchAndNext(h.item)
+	if reason != "" {
+		// the state was changed by another request after the item was selected
+		writeUnmatched(w, unmatchedResult{
+			Message: "no mock item matched the request",
+			Closest: h.item.Name,
+			Reasons: []string{reason},
+		})
+		return
+	}
+
 	h.item.Param = make(map[string]interface{})
 	for k, v := range mux.Vars(req) {
 		h.item.Param[k] = v
@@ -460,6 +510,15 @@ func (h *advanceHandler) handle(w http.ResponseWriter, req *http.Request) {
 	}
 
 	h.item.Param["Host"] = req.Host
+	if len(h.item.Responses) > 0 {
+		response := h.item.Responses[index]
+		// avoid changing the header of the sequence
+		response.Header = make(map[string]string, len(response.Header))
+		for k, v := range h.item.Responses[index].Header {
+			response.Header[k] = v
+		}
+		h.item.Response = response
+	}
 	if h.item.Response.Header == nil {
 		h.item.Response.Header = make(map[string]string)
 	}
@@ -512,9 +571,13 @@ func (h *advanceHandler) handle(w http.ResponseWriter, req *http.Request) {
 	if err == nil {
 		h.item.Response.Header[util.ContentLength] = fmt.Sprintf("%d", len(h.item.Response.BodyData))
 		w.Header().Set(util.ContentLength, h.item.Response.Header[util.ContentLength])
+		if h.item.Response.StatusCode > 0 {
+			w.WriteHeader(h.item.Response.StatusCode)
+		}
 	}
 
 	writeResponse(w, h.item.Response.BodyData, err)
+	h.webhooks.trigger(h.item.Name, h.item.Param)
 }
 
 func writeResponse(w http.ResponseWriter, data []byte, err error) {
@@ -526,26 +589,6 @@ func writeResponse(w http.ResponseWriter, data []byte, err error) {
 	}
 }
 
-func (s *inMemoryServer) initObjectData(obj Object) {
-	if obj.Sample == "" {
-		return
-	}
-
-	defaultCount := 1
-	if obj.InitCount == nil {
-		obj.InitCount = &defaultCount
-	}
-
-	for i := 0; i < *obj.InitCount; i++ {
-		objData, jsonErr := jsonStrToInterface(obj.Sample)
-		if jsonErr == nil {
-			s.data[obj.Name] = append(s.data[obj.Name], objData)
-		} else {
-			memLogger.Info(jsonErr.Error())
-		}
-	}
-}
-
 func (s *inMemoryServer) startWebhook(webhook *Webhook) (err error) {
 	if webhook.Timer == "" || webhook.Name == "" {
 		return
@@ -571,16 +614,15 @@ func (s *inMemoryServer) startWebhook(webhook *Webhook) (err error) {
 				memLogger.Info("stop webhook server", "name", wh.Name)
 				return
 			case <-timer.C:
-				if err = runWebhook(s.ctx, s, wh); err != nil {
-					memLogger.Error(err, "Error when run webhook")
-				}
+				s.webhooks.deliver(*wh, s)
 			}
 		}
 	}(webhook)
 	return
 }
 
-func runWebhook(ctx context.Context, objCtx interface{}, wh *Webhook) (err error) {
+// runWebhook sends the webhook request, the delivery is recorded into the entry
+func runWebhook(ctx context.Context, objCtx interface{}, wh *Webhook) (entry JournalEntry, err error) {
 	rawParams := make(map[string]string, len(wh.Param))
 	paramKeys := make([]string, 0, len(wh.Param))
 	for k, v := range wh.Param {
@@ -598,15 +640,15 @@ func runWebhook(ctx context.Context, objCtx interface{}, wh *Webhook) (err error
 
 	if wh.Request.BodyFromFile != "" {
 		if data, readErr := os.ReadFile(wh.Request.BodyFromFile); readErr != nil {
-			memLogger.Error(readErr, "failed to read file", "file", wh.Request.BodyFromFile)
+			err = fmt.Errorf("failed to read file %q: %w", wh.Request.BodyFromFile, readErr)
 			return
 		} else {
 			wh.Request.Body = string(data)
 		}
 	}
 
-	var payload io.Reader
-	payload, err = render.RenderAsReader("mock webhook server payload", wh.Request.Body, wh)
+	var payload string
+	payload, err = render.Render("mock webhook server payload", wh.Request.Body, wh)
 	if err != nil {
 		err = fmt.Errorf("error when render payload: %w", err)
 		return
@@ -620,11 +662,18 @@ func runWebhook(ctx context.Context, objCtx interface{}, wh *Webhook) (err error
 		return
 	}
 
+	entry.Path = api
+	entry.Body = payload
+	if len(entry.Body) > maxJournalBodySize {
+		entry.Body = entry.Body[:maxJournalBodySize]
+	}
 	switch wh.Request.Protocol {
 	case "syslog":
-		err = sendSyslogWebhookRequest(ctx, wh, api, payload)
+		entry.Method = "SYSLOG"
+		err = sendSyslogWebhookRequest(ctx, wh, api, strings.NewReader(payload))
 	default:
-		err = sendHTTPWebhookRequest(ctx, wh, api, payload)
+		entry.Method = util.EmptyThenDefault(wh.Request.Method, http.MethodPost)
+		entry.Status, err = sendHTTPWebhookRequest(ctx, wh, api, strings.NewReader(payload))
 	}
 	return
 }
@@ -637,7 +686,7 @@ func sendSyslogWebhookRequest(ctx context.Context, wh *Webhook, api string, payl
 	return
 }
 
-func sendHTTPWebhookRequest(ctx context.Context, wh *Webhook, api string, payload io.Reader) (err error) {
+func sendHTTPWebhookRequest(ctx context.Context, wh *Webhook, api string, payload io.Reader) (code int, err error) {
 	method := util.EmptyThenDefault(wh.Request.Method, http.MethodPost)
 	client := http.DefaultClient
 
@@ -669,12 +718,14 @@ func sendHTTPWebhookRequest(ctx context.Context, wh *Webhook, api string, payloa
 	if err != nil {
 		err = fmt.Errorf("error when sending webhook: %v", err)
 	} else {
-		if resp.StatusCode != http.StatusOK {
-			memLogger.Info("unexpected status", "code", resp.StatusCode)
-		}
-
+		defer resp.Body.Close()
+		code = resp.StatusCode
 		data, _ := io.ReadAll(resp.Body)
 		memLogger.V(7).Info("received from webhook", "code", resp.StatusCode, "response", string(data))
+
+		if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+			err = fmt.Errorf("unexpected status code %d of webhook", resp.StatusCode)
+		}
 	}
 	return
 }
@@ -761,6 +812,7 @@ func (s *inMemoryServer) Stop() (err error) {
 	if s.cancelFunc != nil {
 		s.cancelFunc()
 	}
+	s.grpc.stop()
 	s.wg.Wait()
 	return
 }
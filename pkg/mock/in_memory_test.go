@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
@@ -236,11 +237,32 @@ func TestInMemoryServer(t *testing.T) {
 	t.Run("proxy", func(t *testing.T) {
 		resp, err = http.Get(api + "/v1/myProjects")
 		assert.NoError(t, err)
-		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		// the status code of the target is kept, there is no such API without the prefix
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
 
 		resp, err = http.Get(api + "/v1/invalid-template")
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(r.URL.RawQuery))
+		}))
+		defer target.Close()
+
+		proxyServer := NewInMemoryServer(context.Background(), 0)
+		err := proxyServer.Start(NewInMemoryReader(`proxies:
+  - path: /v1/echo
+    target: `+target.URL), "/")
+		assert.NoError(t, err)
+		defer proxyServer.Stop()
+
+		resp, err = http.Get("http://localhost:" + proxyServer.GetPort() + "/v1/echo?name=rick")
+		if assert.NoError(t, err) {
+			assert.Equal(t, http.StatusCreated, resp.StatusCode)
+			data, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, "name=rick", string(data))
+		}
 	})
 
 	t.Run("metrics", func(t *testing.T) {
@@ -0,0 +1,313 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+const (
+	defaultJournalSize = 1000
+	// the request body is truncated in the journal if it is too large
+	maxJournalBodySize = 64 * 1024
+)
+
+// JournalKindWebhook is the kind of the webhook deliveries, the received requests have an empty kind
+const JournalKindWebhook = "webhook"
+
+// JournalEntry is a received request or a webhook delivery of the mock server
+type JournalEntry struct {
+	Kind   string            `json:"kind,omitempty"`
+	Time   time.Time         `json:"time"`
+	Method string            `json:"method"`
+	Path   string            `json:"path"`
+	Query  string            `json:"query"`
+	Header map[string]string `json:"header"`
+	Body   string            `json:"body"`
+	// Item is the name of the matched item, it is empty if no item matched
+	Item   string `json:"item"`
+	Status int    `json:"status"`
+	// Error and Attempt are the outcome of a webhook delivery
+	Error   string `json:"error,omitempty"`
+	Attempt int    `json:"attempt,omitempty"`
+}
+
+// JournalFilter filters the journal entries, the empty fields are ignored
+type JournalFilter struct {
+	// Kind selects the received requests if it is empty, or the webhook deliveries
+	Kind   string `json:"kind"`
+	Item   string `json:"item"`
+	Method string `json:"method"`
+	// Path and Body are regular expressions
+	Path string `json:"path"`
+	Body string `json:"body"`
+	// Limit returns the latest entries if it is greater than zero
+	Limit int `json:"limit"`
+}
+
+// JournalVerification is the result of verifying the journal
+type JournalVerification struct {
+	Success bool   `json:"success"`
+	Count   int    `json:"count"`
+	Message string `json:"message"`
+}
+
+// JournalReader reads and resets the journal of the received requests
+type JournalReader interface {
+	GetJournal(filter JournalFilter) ([]JournalEntry, error)
+	ResetJournal()
+	// VerifyJournal checks the count of the matched requests, it should be at least once if times is nil
+	VerifyJournal(filter JournalFilter, times *int) (JournalVerification, error)
+}
+
+var _ JournalReader = &inMemoryServer{}
+
+// requestJournal is a ring buffer of the received requests
+type requestJournal struct {
+	entries []JournalEntry
+	next    int
+	full    bool
+	mu      sync.RWMutex
+}
+
+func newRequestJournal(size int) *requestJournal {
+	return &requestJournal{entries: make([]JournalEntry, size)}
+}
+
+func (j *requestJournal) record(entry JournalEntry) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.entries[j.next] = entry
+	j.next = (j.next + 1) % len(j.entries)
+	if j.next == 0 {
+		j.full = true
+	}
+}
+
+func (j *requestJournal) reset() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.entries = make([]JournalEntry, len(j.entries))
+	j.next = 0
+	j.full = false
+}
+
+// list returns the matched entries in the received order
+func (j *requestJournal) list(filter JournalFilter) (result []JournalEntry, err error) {
+	var pathReg, bodyReg *regexp.Regexp
+	if filter.Path != "" {
+		if pathReg, err = regexp.Compile(filter.Path); err != nil {
+			err = fmt.Errorf("invalid path regex: %v", err)
+			return
+		}
+	}
+	if filter.Body != "" {
+		if bodyReg, err = regexp.Compile(filter.Body); err != nil {
+			err = fmt.Errorf("invalid body regex: %v", err)
+			return
+		}
+	}
+
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	entries := j.entries[:j.next]
+	if j.full {
+		entries = append(append([]JournalEntry{}, j.entries[j.next:]...), j.entries[:j.next]...)
+	}
+
+	result = []JournalEntry{}
+	for _, entry := range entries {
+		if filter.Kind != entry.Kind ||
+			(filter.Item != "" && filter.Item != entry.Item) ||
+			(filter.Method != "" && !strings.EqualFold(filter.Method, entry.Method)) ||
+			(pathReg != nil && !pathReg.MatchString(entry.Path)) ||
+			(bodyReg != nil && !bodyReg.MatchString(entry.Body)) {
+			continue
+		}
+		result = append(result, entry)
+	}
+	if filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[len(result)-filter.Limit:]
+	}
+	return
+}
+
+func (s *inMemoryServer) GetJournal(filter JournalFilter) ([]JournalEntry, error) {
+	return s.journal.list(filter)
+}
+
+func (s *inMemoryServer) ResetJournal() {
+	s.journal.reset()
+}
+
+func (s *inMemoryServer) VerifyJournal(filter JournalFilter, times *int) (result JournalVerification, err error) {
+	filter.Limit = 0
+	var entries []JournalEntry
+	if entries, err = s.journal.list(filter); err != nil {
+		return
+	}
+
+	result.Count = len(entries)
+	if times == nil {
+		result.Success = result.Count > 0
+		if !result.Success {
+			result.Message = "expected to be called at least once, but it was never called"
+		}
+	} else {
+		result.Success = result.Count == *times
+		if !result.Success {
+			result.Message = fmt.Sprintf("expected to be called %d times, but it was called %d times", *times, result.Count)
+		}
+	}
+	return
+}
+
+// isInternalPath checks if it is the path of the built-in endpoints, such as: /_journal.
+// The other paths which start with "/_" belong to the mock items, such as: /_health
+func (s *inMemoryServer) isInternalPath(path string) bool {
+	path = strings.TrimPrefix(path, strings.TrimRight(s.prefix, "/"))
+	switch path {
+	case "/_journal", "/_journal/verify", "/_scenarios":
+		return true
+	}
+	name, ok := strings.CutPrefix(path, "/_scenarios/")
+	return ok && name != "" && !strings.Contains(name, "/")
+}
+
+func newJournalEntry(req *http.Request, body []byte, item string) JournalEntry {
+	header := make(map[string]string, len(req.Header))
+	for key := range req.Header {
+		header[key] = req.Header.Get(key)
+	}
+	if len(body) > maxJournalBodySize {
+		body = body[:maxJournalBodySize]
+	}
+	return JournalEntry{
+		Time:   time.Now(),
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.RawQuery,
+		Header: header,
+		Body:   string(body),
+		Item:   item,
+		Status: http.StatusOK,
+	}
+}
+
+// statusRecorder records the status code of the response
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	if r.status == 0 {
+		r.status = statusCode
+	}
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *statusRecorder) Write(data []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.ResponseWriter.Write(data)
+}
+
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if hijacker, ok := r.ResponseWriter.(http.Hijacker); ok {
+		return hijacker.Hijack()
+	}
+	return nil, nil, errors.New("hijack is not supported")
+}
+
+// addJournalHandler adds the endpoints to query, reset and verify the journal
+func (s *inMemoryServer) addJournalHandler() {
+	s.mux.HandleFunc("/_journal", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set(util.ContentType, util.JSON)
+		switch req.Method {
+		case http.MethodGet:
+			filter, err := getJournalFilter(req)
+			var data []byte
+			if err == nil {
+				var entries []JournalEntry
+				if entries, err = s.GetJournal(filter); err == nil {
+					data, err = json.Marshal(entries)
+				}
+			}
+			writeResponse(w, data, err)
+		case http.MethodDelete:
+			s.ResetJournal()
+			writeResponse(w, []byte("[]"), nil)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	s.mux.HandleFunc("/_journal/verify", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set(util.ContentType, util.JSON)
+		filter, err := getJournalFilter(req)
+
+		var times *int
+		if err == nil && req.URL.Query().Has("times") {
+			var count int
+			if count, err = strconv.Atoi(req.URL.Query().Get("times")); err == nil {
+				times = &count
+			}
+		}
+
+		var data []byte
+		if err == nil {
+			var result JournalVerification
+			if result, err = s.VerifyJournal(filter, times); err == nil {
+				data, err = json.Marshal(result)
+			}
+		}
+		writeResponse(w, data, err)
+	}).Methods(http.MethodGet)
+}
+
+func getJournalFilter(req *http.Request) (filter JournalFilter, err error) {
+	query := req.URL.Query()
+	filter = JournalFilter{
+		Kind:   query.Get("kind"),
+		Item:   query.Get("item"),
+		Method: query.Get("method"),
+		Path:   query.Get("path"),
+		Body:   query.Get("body"),
+	}
+	if limit := query.Get("limit"); limit != "" {
+		filter.Limit, err = strconv.Atoi(limit)
+	}
+	return
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestJournal(t *testing.T) {
+	journal := newRequestJournal(3)
+	for _, path := range []string{"/a", "/b", "/c", "/d"} {
+		journal.record(JournalEntry{Path: path, Method: http.MethodGet})
+	}
+
+	entries, err := journal.list(JournalFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/b", "/c", "/d"}, journalPaths(entries), "the oldest one should be dropped")
+
+	entries, err = journal.list(JournalFilter{Limit: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/d"}, journalPaths(entries))
+
+	entries, err = journal.list(JournalFilter{Path: "^/[bc]$", Method: "get"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/b", "/c"}, journalPaths(entries))
+
+	_, err = journal.list(JournalFilter{Path: "("})
+	assert.ErrorContains(t, err, "invalid path regex")
+	_, err = journal.list(JournalFilter{Body: "("})
+	assert.ErrorContains(t, err, "invalid body regex")
+
+	journal.reset()
+	entries, err = journal.list(JournalFilter{})
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func journalPaths(entries []JournalEntry) (paths []string) {
+	for _, entry := range entries {
+		paths = append(paths, entry.Path)
+	}
+	return
+}
+
+func TestJournalHandler(t *testing.T) {
+	server := NewInMemoryServer(context.Background(), 0)
+	handler, err := server.SetupHandler(NewInMemoryReader(`items:
+  - name: users
+    request:
+      path: /users
+      method: POST
+    response:
+      statusCode: 201
+      body: ok
+  - name: health
+    request:
+      path: /_health
+    response:
+      body: ok`), "/mock")
+	assert.NoError(t, err)
+
+	request := func(method, path, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(method, "/mock"+path, bytes.NewBufferString(body)))
+		return w
+	}
+	request(http.MethodPost, "/users", `{"name":"rick"}`)
+	request(http.MethodPost, "/users", `{"name":"morty"}`)
+	request(http.MethodGet, "/fake", "")
+	assert.Equal(t, "ok", request(http.MethodGet, "/_health", "").Body.String())
+
+	t.Run("query the journal", func(t *testing.T) {
+		w := request(http.MethodGet, "/_journal?item=users&body=rick", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var entries []JournalEntry
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &entries))
+		if assert.Len(t, entries, 1) {
+			assert.Equal(t, "users", entries[0].Item)
+			assert.Equal(t, "/mock/users", entries[0].Path)
+			assert.Equal(t, `{"name":"rick"}`, entries[0].Body)
+			assert.Equal(t, http.StatusCreated, entries[0].Status)
+			assert.False(t, entries[0].Time.IsZero())
+		}
+
+		entries, err = server.(JournalReader).GetJournal(JournalFilter{})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"/mock/users", "/mock/users", "/mock/fake", "/mock/_health"}, journalPaths(entries),
+			"the unmatched request is recorded, and the journal endpoints are not")
+		assert.Equal(t, "health", entries[3].Item)
+		assert.Equal(t, http.StatusNotFound, entries[2].Status)
+
+		w = request(http.MethodGet, "/_journal?limit=a", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("verify the journal", func(t *testing.T) {
+		for _, tt := range []struct {
+			query  string
+			expect JournalVerification
+		}{{
+			query:  "item=users",
+			expect: JournalVerification{Success: true, Count: 2},
+		}, {
+			query:  "item=users&times=2",
+			expect: JournalVerification{Success: true, Count: 2},
+		}, {
+			query: "item=users&body=rick&times=2",
+			expect: JournalVerification{Count: 1,
+				Message: "expected to be called 2 times, but it was called 1 times"},
+		}, {
+			query: "item=fake",
+			expect: JournalVerification{
+				Message: "expected to be called at least once, but it was never called"},
+		}} {
+			w := request(http.MethodGet, "/_journal/verify?"+tt.query, "")
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var result JournalVerification
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+			assert.Equal(t, tt.expect, result, tt.query)
+		}
+
+		w := request(http.MethodGet, "/_journal/verify?times=a", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("reset the journal", func(t *testing.T) {
+		w := request(http.MethodDelete, "/_journal", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		entries, err := server.(JournalReader).GetJournal(JournalFilter{})
+		assert.NoError(t, err)
+		assert.Empty(t, entries)
+
+		w = request(http.MethodPut, "/_journal", "")
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
@@ -0,0 +1,407 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/tidwall/gjson"
+	"gopkg.in/yaml.v3"
+)
+
+// Matcher matches a value of the request, such as: query parameter, form field, JSON body field.
+// It could be a plain string in the YAML which means equals.
+type Matcher struct {
+	Equals string `yaml:"equals,omitempty" json:"equals"`
+	Regex  string `yaml:"regex,omitempty" json:"regex"`
+	Exists *bool  `yaml:"exists,omitempty" json:"exists"`
+}
+
+// UnmarshalYAML supports a plain string as the expected value
+func (m *Matcher) UnmarshalYAML(value *yaml.Node) (err error) {
+	if value.Kind == yaml.ScalarNode {
+		m.Equals = value.Value
+		return
+	}
+
+	type rawMatcher Matcher
+	raw := rawMatcher{}
+	if err = value.Decode(&raw); err == nil {
+		*m = Matcher(raw)
+	}
+	return
+}
+
+// MarshalYAML writes a plain string if there is the expected value only
+func (m Matcher) MarshalYAML() (interface{}, error) {
+	if m.Regex == "" && m.Exists == nil {
+		return m.Equals, nil
+	}
+	type rawMatcher Matcher
+	return rawMatcher(m), nil
+}
+
+type compiledMatcher struct {
+	Matcher
+	regex *regexp.Regexp
+}
+
+func compileMatchers(kind string, matchers map[string]Matcher) (result map[string]compiledMatcher, err error) {
+	result = make(map[string]compiledMatcher, len(matchers))
+	for key, matcher := range matchers {
+		compiled := compiledMatcher{Matcher: matcher}
+		if matcher.Regex != "" {
+			if compiled.regex, err = regexp.Compile(matcher.Regex); err != nil {
+				err = fmt.Errorf("invalid regex of %s %q: %v", kind, key, err)
+				return
+			}
+		}
+		result[key] = compiled
+	}
+	return
+}
+
+// match returns the reason if the value does not match
+func (m compiledMatcher) match(value string, exists bool) (reason string) {
+	switch {
+	case m.Exists != nil && *m.Exists != exists:
+		if exists {
+			reason = "expected not to exist"
+		} else {
+			reason = "expected to exist"
+		}
+	case m.Exists != nil && !exists:
+	case !exists:
+		reason = "does not exist"
+	case m.Equals != "" && m.Equals != value:
+		reason = fmt.Sprintf("expected %q, got %q", m.Equals, value)
+	case m.regex != nil && !m.regex.MatchString(value):
+		reason = fmt.Sprintf("%q does not match regex %q", value, m.Regex)
+	}
+	return
+}
+
+type itemMatcher struct {
+	name        string
+	priority    int
+	fallback    bool
+	methods     []string
+	path        *mux.Route
+	header      map[string]string
+	headerRegex map[string]*regexp.Regexp
+	query       map[string]compiledMatcher
+	form        map[string]compiledMatcher
+	bodyJSON    map[string]compiledMatcher
+}
+
+func newItemMatcher(item Item, prefix string) (m *itemMatcher, err error) {
+	m = &itemMatcher{
+		name:        item.Name,
+		priority:    item.Priority,
+		fallback:    item.Fallback,
+		methods:     strings.Split(util.EmptyThenDefault(item.Request.Method, http.MethodGet), ","),
+		header:      item.Request.Header,
+		headerRegex: make(map[string]*regexp.Regexp, len(item.Request.HeaderRegex)),
+	}
+
+	m.path = mux.NewRouter().NewRoute().Path(strings.TrimRight(prefix, "/") + item.Request.Path)
+	if err = m.path.GetError(); err != nil {
+		err = fmt.Errorf("invalid path of item %q: %v", item.Name, err)
+		return
+	}
+
+	for key, val := range item.Request.HeaderRegex {
+		if m.headerRegex[key], err = regexp.Compile(val); err != nil {
+			err = fmt.Errorf("invalid regex of header %q in item %q: %v", key, item.Name, err)
+			return
+		}
+	}
+
+	if m.query, err = compileMatchers("query", item.Request.Query); err == nil {
+		if m.form, err = compileMatchers("form", item.Request.Form); err == nil {
+			m.bodyJSON, err = compileMatchers("body JSON path", item.Request.BodyJSON)
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("%v in item %q", err, item.Name)
+	}
+	return
+}
+
+// requestData holds the parsed data of a request for matching
+type requestData struct {
+	req  *http.Request
+	body []byte
+	form url.Values
+}
+
+func newRequestData(req *http.Request, body []byte) *requestData {
+	data := &requestData{req: req, body: body, form: url.Values{}}
+	if strings.HasPrefix(req.Header.Get(util.ContentType), util.Form) {
+		data.form, _ = url.ParseQuery(string(body))
+	}
+	return data
+}
+
+// evaluate returns the reasons of the mismatches, and a score which represents how close the request is
+func (m *itemMatcher) evaluate(data *requestData, scenarios *scenarioStore) (score int, reasons []string) {
+	check := func(reason string) {
+		if reason == "" {
+			score++
+		} else {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	// the path is the most important one
+	if m.path.Match(data.req, &mux.RouteMatch{}) {
+		score += len(m.query) + len(m.form) + len(m.bodyJSON) + len(m.header) + len(m.headerRegex) + 2
+	} else {
+		reasons = append(reasons, fmt.Sprintf("path %q does not match", data.req.URL.Path))
+	}
+
+	if slices.Contains(m.methods, data.req.Method) {
+		check("")
+	} else {
+		check(fmt.Sprintf("method %q is not one of %v", data.req.Method, m.methods))
+	}
+
+	for _, key := range sortedKeys(m.header) {
+		if val := data.req.Header.Get(key); val != m.header[key] {
+			check(fmt.Sprintf("header %q: expected %q, got %q", key, m.header[key], val))
+		} else {
+			check("")
+		}
+	}
+	for _, key := range sortedKeys(m.headerRegex) {
+		if val := data.req.Header.Get(key); !m.headerRegex[key].MatchString(val) {
+			check(fmt.Sprintf("header %q: %q does not match regex %q", key, val, m.headerRegex[key].String()))
+		} else {
+			check("")
+		}
+	}
+
+	query := data.req.URL.Query()
+	for _, key := range sortedKeys(m.query) {
+		_, exists := query[key]
+		check(prefixReason("query "+key, m.query[key].match(query.Get(key), exists)))
+	}
+	for _, key := range sortedKeys(m.form) {
+		_, exists := data.form[key]
+		check(prefixReason("form "+key, m.form[key].match(data.form.Get(key), exists)))
+	}
+	if len(m.bodyJSON) > 0 {
+		validJSON := gjson.ValidBytes(data.body)
+		for _, key := range sortedKeys(m.bodyJSON) {
+			if !validJSON {
+				check(fmt.Sprintf("body JSON path %q: the body is not a valid JSON", key))
+				continue
+			}
+			result := gjson.GetBytes(data.body, key)
+			check(prefixReason("body JSON path "+key, m.bodyJSON[key].match(result.String(), result.Exists())))
+		}
+	}
+
+	check(scenarios.match(m.name))
+	return
+}
+
+func prefixReason(name, reason string) string {
+	if reason != "" {
+		reason = fmt.Sprintf("%s: %s", name, reason)
+	}
+	return reason
+}
+
+func sortedKeys[T any](data map[string]T) (keys []string) {
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return
+}
+
+type requestMatchers struct {
+	items     []*itemMatcher
+	scenarios *scenarioStore
+	mu        sync.RWMutex
+}
+
+func newRequestMatchers(scenarios *scenarioStore) *requestMatchers {
+	return &requestMatchers{scenarios: scenarios}
+}
+
+// load compiles the matchers of the items, they are sorted by the priority
+func (r *requestMatchers) load(items []Item, prefix string) (err error) {
+	matchers := make([]*itemMatcher, 0, len(items))
+	for _, item := range items {
+		var matcher *itemMatcher
+		if matcher, err = newItemMatcher(item, prefix); err != nil {
+			return
+		}
+		matchers = append(matchers, matcher)
+	}
+	sortByPriority(matchers, func(m *itemMatcher) (int, bool) {
+		return m.priority, m.fallback
+	})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.items = matchers
+	return
+}
+
+// sortByPriority keeps the regular items prior to the fallback ones, then the higher priority comes first.
+// The items with the same priority keep the order of the config.
+func sortByPriority[T any](items []T, rank func(T) (priority int, fallback bool)) {
+	sort.SliceStable(items, func(i, j int) bool {
+		priorityI, fallbackI := rank(items[i])
+		priorityJ, fallbackJ := rank(items[j])
+		if fallbackI != fallbackJ {
+			return !fallbackI
+		}
+		return priorityI > priorityJ
+	})
+}
+
+// selectItem returns the name of the first matched item, the regular items are prior to the fallback ones
+func (r *requestMatchers) selectItem(data *requestData) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, item := range r.items {
+		if _, reasons := item.evaluate(data, r.scenarios); len(reasons) == 0 {
+			return item.name
+		}
+	}
+	return ""
+}
+
+// matchItem checks the item by itself, it is used when the item was not selected in advance
+func (r *requestMatchers) matchItem(name string, data *requestData) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, item := range r.items {
+		if item.name == name {
+			_, reasons := item.evaluate(data, r.scenarios)
+			return len(reasons) == 0
+		}
+	}
+	return false
+}
+
+// closest returns the item which is closest to the request, and the reasons why it does not match
+func (r *requestMatchers) closest(data *requestData) (name string, reasons []string) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	maxScore := -1
+	for _, item := range r.items {
+		if score, itemReasons := item.evaluate(data, r.scenarios); score > maxScore {
+			maxScore = score
+			name = item.name
+			reasons = itemReasons
+		}
+	}
+	return
+}
+
+type selectedItemKey struct{}
+
+type selectedItem struct {
+	name string
+}
+
+// readBody reads the request body and keeps it readable for the handlers
+func readBody(req *http.Request) (body []byte) {
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			memLogger.Error(err, "failed to read request body")
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	return
+}
+
+// matchHandler selects the mock item before routing, so that the item with higher priority wins.
+// The requests are recorded in the journal as well.
+func (s *inMemoryServer) matchHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if s.isInternalPath(req.URL.Path) {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		// the body is always read for the journal
+		body := readBody(req)
+		selected := &selectedItem{name: s.matchers.selectItem(newRequestData(req, body))}
+		entry := newJournalEntry(req, body, selected.name)
+		recorder := &statusRecorder{ResponseWriter: w}
+		defer func() {
+			if recorder.status != 0 {
+				entry.Status = recorder.status
+			}
+			s.journal.record(entry)
+		}()
+		next.ServeHTTP(recorder, req.WithContext(context.WithValue(req.Context(), selectedItemKey{}, selected)))
+	})
+}
+
+// routeMatcher is the gorilla/mux matcher of the item route
+func (s *inMemoryServer) routeMatcher(name string) mux.MatcherFunc {
+	return func(req *http.Request, _ *mux.RouteMatch) bool {
+		if selected, ok := req.Context().Value(selectedItemKey{}).(*selectedItem); ok {
+			return selected.name == name
+		}
+		return s.matchers.matchItem(name, newRequestData(req, readBody(req)))
+	}
+}
+
+type unmatchedResult struct {
+	Message string   `json:"message"`
+	Closest string   `json:"closest,omitempty"`
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// notFoundHandler explains which item is the closest one when no route matches
+func (s *inMemoryServer) notFoundHandler(w http.ResponseWriter, req *http.Request) {
+	result := unmatchedResult{
+		Message: "no mock item matched the request",
+	}
+	result.Closest, result.Reasons = s.matchers.closest(newRequestData(req, readBody(req)))
+	memLogger.Info("no mock item matched", "method", req.Method, "path", req.URL.Path,
+		"closest", result.Closest, "reasons", result.Reasons)
+	writeUnmatched(w, result)
+}
+
+func writeUnmatched(w http.ResponseWriter, result unmatchedResult) {
+	data, _ := json.Marshal(result)
+	w.Header().Set(util.ContentType, util.JSON)
+	w.WriteHeader(http.StatusNotFound)
+	_, _ = w.Write(data)
+}
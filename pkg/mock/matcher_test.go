@@ -0,0 +1,220 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+const matcherConfig = `items:
+  - name: first-page
+    request:
+      path: /users
+      query:
+        page: "1"
+    response:
+      body: first
+  - name: any-page
+    request:
+      path: /users
+      query:
+        page:
+          regex: ^\d+$
+    response:
+      body: any
+  - name: search
+    priority: 10
+    request:
+      path: /users
+      query:
+        q:
+          exists: true
+    response:
+      body: search
+  - name: admin
+    request:
+      path: /users
+      method: POST
+      bodyJSON:
+        role: admin
+        name:
+          regex: ^a
+    response:
+      body: admin
+  - name: guest
+    request:
+      path: /users
+      method: POST
+      bodyJSON:
+        role:
+          exists: false
+    response:
+      body: guest
+  - name: login
+    request:
+      path: /login
+      method: POST
+      form:
+        username: rick
+      headerRegex:
+        User-Agent: ^curl/
+    response:
+      body: login
+  - name: fallback
+    fallback: true
+    request:
+      path: /users
+      method: GET,POST
+    response:
+      body: fallback`
+
+func TestRequestMatchers(t *testing.T) {
+	server := NewInMemoryServer(context.Background(), 0)
+	handler, err := server.SetupHandler(NewInMemoryReader(matcherConfig), "/mock")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		header map[string]string
+		expect string
+	}{{
+		name:   "query equals",
+		path:   "/users?page=1",
+		expect: "first",
+	}, {
+		name:   "query regex",
+		path:   "/users?page=2",
+		expect: "any",
+	}, {
+		name:   "higher priority",
+		path:   "/users?page=1&q=rick",
+		expect: "search",
+	}, {
+		name:   "fallback",
+		path:   "/users?page=a",
+		expect: "fallback",
+	}, {
+		name:   "json body",
+		method: http.MethodPost,
+		path:   "/users",
+		body:   `{"role":"admin","name":"alice"}`,
+		expect: "admin",
+	}, {
+		name:   "json body field not exist",
+		method: http.MethodPost,
+		path:   "/users",
+		body:   `{"name":"bob"}`,
+		expect: "guest",
+	}, {
+		name:   "json body not match",
+		method: http.MethodPost,
+		path:   "/users",
+		body:   `{"role":"admin","name":"bob"}`,
+		expect: "fallback",
+	}, {
+		name:   "form and header regex",
+		method: http.MethodPost,
+		path:   "/login",
+		body:   "username=rick",
+		header: map[string]string{
+			util.ContentType: util.Form,
+			"User-Agent":     "curl/8.0",
+		},
+		expect: "login",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(util.EmptyThenDefault(tt.method, http.MethodGet), "/mock"+tt.path, bytes.NewBufferString(tt.body))
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.expect, w.Body.String())
+		})
+	}
+
+	t.Run("explain the closest item", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/mock/login", bytes.NewBufferString("username=morty"))
+		req.Header.Set(util.ContentType, util.Form)
+		req.Header.Set("User-Agent", "curl/8.0")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		result := unmatchedResult{}
+		data, _ := io.ReadAll(w.Body)
+		assert.NoError(t, json.Unmarshal(data, &result))
+		assert.Equal(t, unmatchedResult{
+			Message: "no mock item matched the request",
+			Closest: "login",
+			Reasons: []string{`form username: expected "rick", got "morty"`},
+		}, result)
+	})
+}
+
+func TestItemMatcher(t *testing.T) {
+	t.Run("invalid regex", func(t *testing.T) {
+		_, err := newItemMatcher(Item{Name: "item", Request: Request{
+			Path:        "/",
+			HeaderRegex: map[string]string{"key": "("},
+		}}, "/")
+		assert.ErrorContains(t, err, `invalid regex of header "key" in item "item"`)
+
+		_, err = newItemMatcher(Item{Name: "item", Request: Request{
+			Path:  "/",
+			Query: map[string]Matcher{"key": {Regex: "("}},
+		}}, "/")
+		assert.ErrorContains(t, err, `invalid regex of query "key"`)
+	})
+
+	t.Run("reasons", func(t *testing.T) {
+		notExist := false
+		matcher, err := newItemMatcher(Item{Name: "item", Request: Request{
+			Path:     "/users/{id}",
+			Method:   http.MethodPut,
+			Header:   map[string]string{"Auth": "token"},
+			Query:    map[string]Matcher{"fake": {Exists: &notExist}, "page": {}},
+			BodyJSON: map[string]Matcher{"name": {Equals: "rick"}},
+		}}, "/")
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/fake?fake=1", nil)
+		score, reasons := matcher.evaluate(newRequestData(req, []byte("invalid")), newScenarioStore())
+		assert.Equal(t, 1, score, "only the scenario state matches")
+		assert.Equal(t, []string{
+			`path "/fake" does not match`,
+			`method "GET" is not one of [PUT]`,
+			`header "Auth": expected "token", got ""`,
+			`query fake: expected not to exist`,
+			`query page: does not exist`,
+			`body JSON path "name": the body is not a valid JSON`,
+		}, reasons)
+	})
+}
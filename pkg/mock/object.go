@@ -0,0 +1,490 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+const (
+	// defaultObjectIDField is the ID field of the objects by default
+	defaultObjectIDField = "name"
+	// IDGeneratorUUID generates a random UUID as the ID
+	IDGeneratorUUID = "uuid"
+	// IDGeneratorIncrement generates an auto-increment number as the ID
+	IDGeneratorIncrement = "increment"
+
+	headerTotalCount = "X-Total-Count"
+	headerNextCursor = "X-Next-Cursor"
+)
+
+// objectStore keeps the data of all the objects, it is safe for the concurrent requests
+type objectStore struct {
+	mu      sync.RWMutex
+	data    map[string][]map[string]interface{}
+	objects map[string]Object
+}
+
+func newObjectStore() *objectStore {
+	return &objectStore{
+		data:    map[string][]map[string]interface{}{},
+		objects: map[string]Object{},
+	}
+}
+
+func (o Object) idField() string {
+	return util.EmptyThenDefault(o.IDField, defaultObjectIDField)
+}
+
+func validateObject(obj Object) (err error) {
+	switch obj.IDGenerator {
+	case "", IDGeneratorUUID, IDGeneratorIncrement:
+	default:
+		err = fmt.Errorf("unsupported ID generator %q of object %q", obj.IDGenerator, obj.Name)
+	}
+	return
+}
+
+// load initializes the data of the object from the persistent file, or the sample
+func (s *objectStore) load(obj Object) (err error) {
+	if err = validateObject(obj); err != nil {
+		return
+	}
+
+	var items []map[string]interface{}
+	var data []byte
+	if obj.File != "" {
+		if data, err = os.ReadFile(obj.File); err == nil {
+			if err = json.Unmarshal(data, &items); err != nil {
+				err = fmt.Errorf("failed to parse the data file %q of object %q: %v", obj.File, obj.Name, err)
+				return
+			}
+		} else if errors.Is(err, os.ErrNotExist) {
+			err = nil
+			data = nil
+		} else {
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[obj.Name] = obj
+	if data == nil {
+		items = s.sampleItems(obj)
+	}
+	s.data[obj.Name] = items
+	return
+}
+
+func (s *objectStore) sampleItems(obj Object) (items []map[string]interface{}) {
+	if obj.Sample == "" {
+		return
+	}
+
+	count := 1
+	if obj.InitCount != nil {
+		count = *obj.InitCount
+	}
+	for i := 0; i < count; i++ {
+		objData, jsonErr := jsonStrToInterface(obj.Sample)
+		if jsonErr != nil {
+			memLogger.Info(jsonErr.Error())
+			continue
+		}
+		if _, ok := objData[obj.idField()]; !ok && obj.IDGenerator != "" {
+			objData[obj.idField()] = s.nextID(obj, items)
+		}
+		items = append(items, objData)
+	}
+	return
+}
+
+func (s *objectStore) nextID(obj Object, items []map[string]interface{}) interface{} {
+	if obj.IDGenerator == IDGeneratorUUID {
+		return uuid.NewString()
+	}
+
+	var max int64
+	for _, item := range items {
+		if id, err := strconv.ParseInt(fmt.Sprint(item[obj.idField()]), 10, 64); err == nil && id > max {
+			max = id
+		}
+	}
+	return max + 1
+}
+
+// save writes the data into the persistent file, it should be called with the lock
+func (s *objectStore) save(name string) {
+	obj := s.objects[name]
+	if obj.File == "" {
+		return
+	}
+
+	data, err := json.MarshalIndent(s.data[name], "", "  ")
+	if err == nil {
+		err = os.WriteFile(obj.File, data, 0644)
+	}
+	if err != nil {
+		memLogger.Error(err, "failed to save the object data", "name", name, "file", obj.File)
+	}
+}
+
+func (s *objectStore) indexOf(name, id string) int {
+	idField := s.objects[name].idField()
+	for i, item := range s.data[name] {
+		if val, ok := item[idField]; ok && fmt.Sprint(val) == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// listOptions are the reserved query parameters of listing objects, all the parameters are filters if not strict
+type listOptions struct {
+	limit  int
+	offset int
+	cursor string
+	sort   []string
+	filter map[string]string
+}
+
+func parseListOptions(req *http.Request, strict bool) (options listOptions, err error) {
+	options.filter = map[string]string{}
+	for key, values := range req.URL.Query() {
+		if len(values) == 0 {
+			continue
+		}
+
+		value := values[0]
+		if !strict {
+			options.filter[key] = value
+			continue
+		}
+		switch key {
+		case "limit":
+			if options.limit, err = strconv.Atoi(value); err != nil || options.limit < 0 {
+				err = fmt.Errorf("invalid limit %q", value)
+			}
+		case "offset":
+			if options.offset, err = strconv.Atoi(value); err != nil || options.offset < 0 {
+				err = fmt.Errorf("invalid offset %q", value)
+			}
+		case "cursor":
+			options.cursor = value
+		case "sort":
+			options.sort = strings.Split(value, ",")
+		default:
+			options.filter[key] = value
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// list returns the matched objects of the page, and the total count of the matched objects
+func (s *objectStore) list(name string, options listOptions) (page []map[string]interface{}, total int, next string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	page = make([]map[string]interface{}, 0)
+	for _, item := range s.data[name] {
+		matched := true
+		for k, v := range options.filter {
+			if val, ok := item[k]; ok && fmt.Sprint(val) != v {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			page = append(page, item)
+		}
+	}
+	sortObjects(page, options.sort)
+	total = len(page)
+
+	idField := s.objects[name].idField()
+	start := options.offset
+	if options.cursor != "" {
+		// the cursor is the ID of the last object in the previous page
+		start = len(page)
+		for i, item := range page {
+			if fmt.Sprint(item[idField]) == options.cursor {
+				start = i + 1
+				break
+			}
+		}
+	}
+	if start > len(page) {
+		start = len(page)
+	}
+	end := len(page)
+	if options.limit > 0 && start+options.limit < end {
+		end = start + options.limit
+		next = fmt.Sprint(page[end-1][idField])
+	}
+	page = page[start:end]
+	return
+}
+
+// sortObjects sorts by the fields in order, the descending order starts with "-", such as: -age
+func sortObjects(items []map[string]interface{}, fields []string) {
+	if len(fields) == 0 {
+		return
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		for _, field := range fields {
+			desc := strings.HasPrefix(field, "-")
+			field = strings.TrimPrefix(field, "-")
+			if result := compareValues(items[i][field], items[j][field]); result != 0 {
+				return (result < 0) != desc
+			}
+		}
+		return false
+	})
+}
+
+func compareValues(a, b interface{}) int {
+	if x, ok := a.(float64); ok {
+		if y, ok := b.(float64); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	if a == nil || b == nil {
+		// the missing values are the last ones
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return 1
+		}
+		return -1
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// create adds a new object, the ID is generated if it is missing. The duplicated ID is rejected if strict
+func (s *objectStore) create(name string, objData map[string]interface{}) (status int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj := s.objects[name]
+	id, ok := objData[obj.idField()]
+	if !ok && obj.IDGenerator != "" {
+		objData[obj.idField()] = s.nextID(obj, s.data[name])
+	} else if ok && obj.Strict && s.indexOf(name, fmt.Sprint(id)) >= 0 {
+		status, err = http.StatusConflict, fmt.Errorf("object %q already exists", fmt.Sprint(id))
+		return
+	}
+
+	s.data[name] = append(s.data[name], objData)
+	s.save(name)
+	status = http.StatusOK
+	if obj.Strict {
+		status = http.StatusCreated
+	}
+	return
+}
+
+func (s *objectStore) get(name, id string) (objData map[string]interface{}) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if index := s.indexOf(name, id); index >= 0 {
+		objData = s.data[name][index]
+	}
+	return
+}
+
+// update replaces the object, or merges the JSON Merge Patch into it
+func (s *objectStore) update(name, id string, payload []byte, patch bool) (objData map[string]interface{}, status int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	index := s.indexOf(name, id)
+	if index < 0 {
+		status, err = http.StatusNotFound, fmt.Errorf("object %q is not found", id)
+		return
+	}
+
+	if patch {
+		var current []byte
+		if current, err = json.Marshal(s.data[name][index]); err == nil {
+			payload, err = jsonpatch.MergePatch(current, payload)
+		}
+		if err != nil {
+			status = http.StatusBadRequest
+			return
+		}
+	}
+
+	objData = map[string]interface{}{}
+	if err = json.Unmarshal(payload, &objData); err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+	if _, ok := objData[s.objects[name].idField()]; !ok {
+		objData[s.objects[name].idField()] = s.data[name][index][s.objects[name].idField()]
+	}
+
+	s.data[name][index] = objData
+	s.save(name)
+	status = http.StatusOK
+	return
+}
+
+func (s *objectStore) delete(name, id string) (status int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	index := s.indexOf(name, id)
+	if index < 0 {
+		status, err = http.StatusNotFound, fmt.Errorf("object %q is not found", id)
+		return
+	}
+
+	s.data[name] = append(s.data[name][:index], s.data[name][index+1:]...)
+	s.save(name)
+	status = http.StatusOK
+	return
+}
+
+// startObject creates a simple CRUD server of the object
+func (s *inMemoryServer) startObject(obj Object) {
+	s.mux.HandleFunc("/"+obj.Name, func(w http.ResponseWriter, req *http.Request) {
+		memLogger.Info("mock server received request", "path", req.URL.Path)
+		s.metrics.RecordRequest(req.URL.Path)
+		w.Header().Set(util.ContentType, util.JSON)
+
+		switch req.Method {
+		case http.MethodGet:
+			options, err := parseListOptions(req, obj.Strict)
+			if err != nil {
+				writeObjectError(w, obj, http.StatusBadRequest, err)
+				return
+			}
+
+			items, total, next := s.objects.list(obj.Name, options)
+			w.Header().Set(headerTotalCount, strconv.Itoa(total))
+			if next != "" {
+				w.Header().Set(headerNextCursor, next)
+			}
+			data, err := json.Marshal(items)
+			writeResponse(w, data, err)
+		case http.MethodPost:
+			objData, err := readObject(req)
+			if err != nil {
+				writeObjectError(w, obj, http.StatusBadRequest, err)
+				return
+			}
+
+			var status int
+			if status, err = s.objects.create(obj.Name, objData); err != nil {
+				writeObjectError(w, obj, status, err)
+				return
+			}
+
+			data, err := json.Marshal(objData)
+			if err == nil {
+				w.WriteHeader(status)
+			}
+			writeResponse(w, data, err)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	// handle a single object
+	s.mux.HandleFunc(fmt.Sprintf("/%s/{id}", obj.Name), func(w http.ResponseWriter, req *http.Request) {
+		s.metrics.RecordRequest(req.URL.Path)
+		w.Header().Set(util.ContentType, util.JSON)
+		id := mux.Vars(req)["id"]
+
+		var objData map[string]interface{}
+		var status int
+		var err error
+		switch req.Method {
+		case http.MethodGet:
+			if objData = s.objects.get(obj.Name, id); objData == nil {
+				status, err = http.StatusNotFound, fmt.Errorf("object %q is not found", id)
+			}
+		case http.MethodPut, http.MethodPatch:
+			var payload []byte
+			if payload, err = io.ReadAll(req.Body); err == nil {
+				objData, status, err = s.objects.update(obj.Name, id, payload, req.Method == http.MethodPatch)
+			}
+		case http.MethodDelete:
+			if status, err = s.objects.delete(obj.Name, id); err == nil {
+				writeResponse(w, []byte(`{"msg": "deleted"}`), nil)
+				return
+			}
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		if err != nil {
+			writeObjectError(w, obj, util.ZeroThenDefault(status, http.StatusInternalServerError), err)
+			return
+		}
+
+		data, err := json.Marshal(objData)
+		writeResponse(w, data, err)
+	})
+}
+
+func readObject(req *http.Request) (objData map[string]interface{}, err error) {
+	var data []byte
+	if data, err = io.ReadAll(req.Body); err == nil {
+		objData = map[string]interface{}{}
+		err = json.Unmarshal(data, &objData)
+	}
+	return
+}
+
+// writeObjectError responds the error, the invalid payload is ignored without a response if not strict
+func writeObjectError(w http.ResponseWriter, obj Object, status int, err error) {
+	if status == http.StatusBadRequest && !obj.Strict {
+		memLogger.Info("ignore the invalid payload", "name", obj.Name, "error", err.Error())
+		return
+	}
+
+	data, _ := json.Marshal(map[string]string{"message": err.Error()})
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectStore(t *testing.T) {
+	dataFile := filepath.Join(t.TempDir(), "users.json")
+	config := fmt.Sprintf(`objects:
+  - name: users
+    idField: id
+    idGenerator: increment
+    file: %s
+    strict: true
+    initCount: 2
+    sample: |
+      {"name": "rick", "age": 70}`, dataFile)
+
+	server := NewInMemoryServer(context.Background(), 0)
+	handler, err := server.SetupHandler(NewInMemoryReader(config), "/mock")
+	assert.NoError(t, err)
+
+	request := func(method, path, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(method, "/mock"+path, bytes.NewBufferString(body)))
+		return w
+	}
+
+	t.Run("create with the auto-generated ID", func(t *testing.T) {
+		w := request(http.MethodPost, "/users", `{"name": "morty", "age": 14}`)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.JSONEq(t, `{"id": 3, "name": "morty", "age": 14}`, w.Body.String())
+
+		w = request(http.MethodPost, "/users", `{"id": 3}`)
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		w = request(http.MethodPost, "/users", `invalid`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("merge patch", func(t *testing.T) {
+		w := request(http.MethodPatch, "/users/3", `{"age": 15, "name": null, "pet": "dog"}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"id": 3, "age": 15, "pet": "dog"}`, w.Body.String())
+
+		w = request(http.MethodPatch, "/users/100", `{}`)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("replace keeps the ID", func(t *testing.T) {
+		w := request(http.MethodPut, "/users/3", `{"name": "morty", "age": 14}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"id": 3, "name": "morty", "age": 14}`, request(http.MethodGet, "/users/3", "").Body.String())
+	})
+
+	t.Run("sort and paginate", func(t *testing.T) {
+		w := request(http.MethodGet, "/users?sort=age,-id&limit=2", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "3", w.Header().Get(headerTotalCount))
+		assert.Equal(t, "2", w.Header().Get(headerNextCursor))
+		assert.JSONEq(t, `[{"id": 3, "name": "morty", "age": 14}, {"id": 2, "name": "rick", "age": 70}]`, w.Body.String())
+
+		w = request(http.MethodGet, "/users?sort=age,-id&limit=2&cursor=2", "")
+		assert.Empty(t, w.Header().Get(headerNextCursor))
+		assert.JSONEq(t, `[{"id": 1, "name": "rick", "age": 70}]`, w.Body.String())
+
+		w = request(http.MethodGet, "/users?offset=1&limit=1&name=rick", "")
+		assert.Equal(t, "2", w.Header().Get(headerTotalCount))
+		assert.JSONEq(t, `[{"id": 2, "name": "rick", "age": 70}]`, w.Body.String())
+
+		w = request(http.MethodGet, "/users?limit=-1", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("concurrent requests", func(t *testing.T) {
+		wg := sync.WaitGroup{}
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				request(http.MethodPost, "/users", `{"name": "someone"}`)
+				request(http.MethodGet, "/users", "")
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, "23", request(http.MethodGet, "/users", "").Header().Get(headerTotalCount))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, request(http.MethodDelete, "/users/3", "").Code)
+		assert.Equal(t, http.StatusNotFound, request(http.MethodDelete, "/users/3", "").Code)
+		assert.Equal(t, http.StatusMethodNotAllowed, request(http.MethodPost, "/users/1", "").Code)
+	})
+
+	t.Run("persist across restarts", func(t *testing.T) {
+		data, err := os.ReadFile(dataFile)
+		assert.NoError(t, err)
+		var items []map[string]interface{}
+		assert.NoError(t, json.Unmarshal(data, &items))
+		assert.Len(t, items, 22)
+
+		server := NewInMemoryServer(context.Background(), 0)
+		handler, err = server.SetupHandler(NewInMemoryReader(config), "/mock")
+		assert.NoError(t, err)
+		assert.Equal(t, "22", request(http.MethodGet, "/users", "").Header().Get(headerTotalCount))
+		assert.Equal(t, http.StatusNotFound, request(http.MethodGet, "/users/3", "").Code)
+	})
+}
+
+func TestObjectStoreCompatible(t *testing.T) {
+	server := NewInMemoryServer(context.Background(), 0)
+	handler, err := server.SetupHandler(NewInMemoryReader(`objects:
+  - name: users
+    sample: |
+      {"name": "rick", "limit": "1"}`), "/mock")
+	assert.NoError(t, err)
+
+	request := func(method, path, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(method, "/mock"+path, bytes.NewBufferString(body)))
+		return w
+	}
+
+	w := request(http.MethodPost, "/users", `{"name": "rick"}`)
+	assert.Equal(t, http.StatusOK, w.Code, "the duplicated object is allowed")
+	assert.JSONEq(t, `{"name": "rick"}`, w.Body.String())
+
+	w = request(http.MethodPost, "/users", `invalid`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.String())
+
+	w = request(http.MethodPut, "/users/rick", `invalid`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.String())
+
+	// the reserved parameters of the strict mode are filters
+	w = request(http.MethodGet, "/users?limit=1", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"name": "rick", "limit": "1"}, {"name": "rick"}]`, w.Body.String())
+
+	w = request(http.MethodGet, "/users?limit=-1", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"name": "rick"}]`, w.Body.String())
+}
+
+func TestObjectStoreInvalid(t *testing.T) {
+	server := NewInMemoryServer(context.Background(), 0)
+	_, err := server.SetupHandler(NewInMemoryReader(`objects:
+  - name: users
+    idGenerator: fake`), "/mock")
+	assert.ErrorContains(t, err, "idGenerator")
+	assert.Error(t, validateObject(Object{Name: "users", IDGenerator: "fake"}))
+
+	dataFile := filepath.Join(t.TempDir(), "users.json")
+	assert.NoError(t, os.WriteFile(dataFile, []byte("invalid"), 0644))
+	_, err = server.SetupHandler(NewInMemoryReader(`objects:
+  - name: users
+    file: `+dataFile), "/mock")
+	assert.ErrorContains(t, err, "failed to parse the data file")
+}
+
+func TestSortObjects(t *testing.T) {
+	items := []map[string]interface{}{
+		{"name": "b", "age": float64(2)},
+		{"name": "a"},
+		{"name": "c", "age": float64(1)},
+	}
+	sortObjects(items, []string{"age"})
+	assert.Equal(t, []interface{}{"c", "b", "a"}, []interface{}{items[0]["name"], items[1]["name"], items[2]["name"]})
+
+	sortObjects(items, []string{"-name"})
+	assert.Equal(t, []interface{}{"c", "b", "a"}, []interface{}{items[0]["name"], items[1]["name"], items[2]["name"]})
+}
@@ -0,0 +1,548 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+const (
+	defaultOIDCPath     = "/oidc"
+	defaultOIDCTokenTTL = time.Hour
+	oidcCodeTTL         = 5 * time.Minute
+	oidcKeySize         = 2048
+)
+
+// oidcProvider is a fake OAuth2/OIDC identity provider, the tokens are JWTs signed by a generated RSA key
+type oidcProvider struct {
+	config *OIDC
+	// prefix is the prefix of the mock server, it is part of the issuer
+	prefix string
+	path   string
+	ttl    time.Duration
+	key    *rsa.PrivateKey
+	keyID  string
+
+	mu            sync.Mutex
+	codes         map[string]*oidcGrant
+	refreshTokens map[string]*oidcGrant
+}
+
+// oidcGrant is the authorization of an authorization code or a refresh token
+type oidcGrant struct {
+	clientID        string
+	redirectURI     string
+	scope           string
+	nonce           string
+	challenge       string
+	challengeMethod string
+	user            *OIDCUser
+	expires         time.Time
+}
+
+func newOIDCProvider(config *OIDC, prefix string) (p *oidcProvider, err error) {
+	p = &oidcProvider{
+		config:        config,
+		prefix:        strings.TrimSuffix(prefix, "/"),
+		path:          util.EmptyThenDefault(config.Path, defaultOIDCPath),
+		ttl:           defaultOIDCTokenTTL,
+		codes:         map[string]*oidcGrant{},
+		refreshTokens: map[string]*oidcGrant{},
+	}
+	if config.TokenTTL != "" {
+		if p.ttl, err = time.ParseDuration(config.TokenTTL); err != nil {
+			err = fmt.Errorf("invalid token TTL: %v", err)
+			return
+		}
+	}
+	if p.key, err = rsa.GenerateKey(rand.Reader, oidcKeySize); err != nil {
+		err = fmt.Errorf("failed to generate the signing key: %v", err)
+		return
+	}
+	keyHash := sha256.Sum256(p.key.PublicKey.N.Bytes())
+	p.keyID = hex.EncodeToString(keyHash[:8])
+	return
+}
+
+func (s *inMemoryServer) startOIDC(config *OIDC) (err error) {
+	var provider *oidcProvider
+	if provider, err = newOIDCProvider(config, s.prefix); err != nil {
+		return
+	}
+
+	memLogger.Info("start the OIDC provider", "path", provider.path, "users", len(config.Users))
+	routes := []struct {
+		path    string
+		methods []string
+		handler http.HandlerFunc
+	}{
+		{"/.well-known/openid-configuration", []string{http.MethodGet}, provider.discovery},
+		{"/keys", []string{http.MethodGet}, provider.keys},
+		{"/auth", []string{http.MethodGet, http.MethodPost}, provider.authorize},
+		{"/token", []string{http.MethodPost}, provider.token},
+		{"/userinfo", []string{http.MethodGet, http.MethodPost}, provider.userinfo},
+	}
+	for _, r := range routes {
+		handler := func(w http.ResponseWriter, req *http.Request) {
+			s.metrics.RecordRequest(req.URL.Path)
+			r.handler(w, req)
+		}
+		path := provider.path + r.path
+		name := fmt.Sprintf("oidc %s", path)
+		if route := s.mux.GetRoute(name); route != nil {
+			route.HandlerFunc(handler)
+		} else {
+			s.mux.NewRoute().Name(name).Methods(r.methods...).Path(path).HandlerFunc(handler)
+		}
+	}
+	return
+}
+
+func (p *oidcProvider) issuer(req *http.Request) string {
+	if p.config.Issuer != "" {
+		return strings.TrimSuffix(p.config.Issuer, "/")
+	}
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s%s%s", scheme, req.Host, p.prefix, p.path)
+}
+
+func (p *oidcProvider) discovery(w http.ResponseWriter, req *http.Request) {
+	issuer := p.issuer(req)
+	writeOIDCJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                issuer,
+		"authorization_endpoint":                issuer + "/auth",
+		"token_endpoint":                        issuer + "/token",
+		"userinfo_endpoint":                     issuer + "/userinfo",
+		"jwks_uri":                              issuer + "/keys",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"scopes_supported":                      []string{"openid", "email", "profile", "groups", "offline_access"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+		"grant_types_supported":                 []string{"authorization_code", "client_credentials", "refresh_token"},
+		"code_challenge_methods_supported":      []string{"S256", "plain"},
+	})
+}
+
+func (p *oidcProvider) keys(w http.ResponseWriter, _ *http.Request) {
+	writeOIDCJSON(w, http.StatusOK, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"alg": "RS256",
+			"use": "sig",
+			"kid": p.keyID,
+			"n":   base64.RawURLEncoding.EncodeToString(p.key.PublicKey.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.PublicKey.E)).Bytes()),
+		}},
+	})
+}
+
+// authorize is the endpoint of the authorization code flow,
+// the user logs in by the form, or the login_hint skips the password for the automated tests
+func (p *oidcProvider) authorize(w http.ResponseWriter, req *http.Request) {
+	if err := req.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	form := req.Form
+	client, ok := p.client(form.Get("client_id"))
+	if !ok {
+		http.Error(w, "invalid client_id", http.StatusBadRequest)
+		return
+	}
+	redirectURI := form.Get("redirect_uri")
+	redirect, err := url.Parse(redirectURI)
+	if err != nil || redirectURI == "" ||
+		(len(client.RedirectURIs) > 0 && !slices.Contains(client.RedirectURIs, redirectURI)) {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	grant := &oidcGrant{
+		clientID:        client.ID,
+		redirectURI:     redirectURI,
+		scope:           form.Get("scope"),
+		nonce:           form.Get("nonce"),
+		challenge:       form.Get("code_challenge"),
+		challengeMethod: form.Get("code_challenge_method"),
+		expires:         time.Now().Add(oidcCodeTTL),
+	}
+	if grant.challenge != "" && grant.challengeMethod == "" {
+		grant.challengeMethod = "plain"
+	}
+
+	query := redirect.Query()
+	if state := form.Get("state"); state != "" {
+		query.Set("state", state)
+	}
+	switch {
+	case form.Get("response_type") != "code":
+		query.Set("error", "unsupported_response_type")
+	case grant.challengeMethod != "" && grant.challengeMethod != "S256" && grant.challengeMethod != "plain":
+		query.Set("error", "invalid_request")
+		query.Set("error_description", "unsupported code_challenge_method")
+	case client.Secret == "" && grant.challenge == "":
+		query.Set("error", "invalid_request")
+		query.Set("error_description", "code_challenge is required for the public client")
+	default:
+		if hint := form.Get("login_hint"); hint != "" && form.Get("username") == "" {
+			grant.user = p.user(hint)
+		} else if username := form.Get("username"); username != "" && req.Method == http.MethodPost {
+			if user := p.user(username); user != nil &&
+				subtle.ConstantTimeCompare([]byte(user.Password), []byte(form.Get("password"))) == 1 {
+				grant.user = user
+			}
+		}
+
+		if grant.user == nil {
+			status := http.StatusOK
+			message := ""
+			if req.Method == http.MethodPost || form.Get("login_hint") != "" {
+				status = http.StatusUnauthorized
+				message = "invalid username or password"
+			}
+			p.loginForm(w, status, form, message)
+			return
+		}
+
+		code := rand.Text()
+		p.mu.Lock()
+		p.codes[code] = grant
+		p.mu.Unlock()
+		query.Set("code", code)
+	}
+
+	redirect.RawQuery = query.Encode()
+	http.Redirect(w, req, redirect.String(), http.StatusFound)
+}
+
+var oidcLoginTemplate = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Login</title></head>
+<body>
+<form method="post">
+{{range $k, $v := .Form}}{{if and (ne $k "username") (ne $k "password")}}<input type="hidden" name="{{$k}}" value="{{index $v 0}}">
+{{end}}{{end}}{{with .Message}}<p>{{.}}</p>
+{{end}}<input name="username" placeholder="Username">
+<input name="password" type="password" placeholder="Password">
+<button type="submit">Login</button>
+</form>
+</body>
+</html>
+`))
+
+func (p *oidcProvider) loginForm(w http.ResponseWriter, status int, form url.Values, message string) {
+	w.Header().Set(util.ContentType, "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := oidcLoginTemplate.Execute(w, map[string]interface{}{
+		"Form":    form,
+		"Message": message,
+	}); err != nil {
+		memLogger.Error(err, "failed to render the login form")
+	}
+}
+
+func (p *oidcProvider) token(w http.ResponseWriter, req *http.Request) {
+	if err := req.ParseForm(); err != nil {
+		writeOIDCError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+
+	clientID, secret, hasBasic := req.BasicAuth()
+	if !hasBasic {
+		clientID, secret = req.PostForm.Get("client_id"), req.PostForm.Get("client_secret")
+	}
+	client, ok := p.client(clientID)
+	if !ok || (client.Secret != "" && subtle.ConstantTimeCompare([]byte(client.Secret), []byte(secret)) != 1) {
+		writeOIDCError(w, http.StatusUnauthorized, "invalid_client", "invalid client credentials")
+		return
+	}
+
+	var grant *oidcGrant
+	var err error
+	form := req.PostForm
+	switch grantType := form.Get("grant_type"); grantType {
+	case "authorization_code":
+		grant, err = p.exchangeCode(client, form)
+	case "refresh_token":
+		p.mu.Lock()
+		grant = p.refreshTokens[form.Get("refresh_token")]
+		if grant != nil && grant.clientID == client.ID {
+			// the refresh token is rotated
+			delete(p.refreshTokens, form.Get("refresh_token"))
+		} else {
+			err = errors.New("invalid refresh_token")
+		}
+		p.mu.Unlock()
+	case "client_credentials":
+		if client.Secret == "" && len(p.config.Clients) > 0 {
+			writeOIDCError(w, http.StatusUnauthorized, "unauthorized_client", "the public client cannot use client_credentials")
+			return
+		}
+		grant = &oidcGrant{clientID: client.ID, scope: form.Get("scope")}
+	default:
+		writeOIDCError(w, http.StatusBadRequest, "unsupported_grant_type", fmt.Sprintf("unsupported grant_type %q", grantType))
+		return
+	}
+	if err != nil {
+		writeOIDCError(w, http.StatusBadRequest, "invalid_grant", err.Error())
+		return
+	}
+
+	var result map[string]interface{}
+	if result, err = p.issueTokens(req, grant); err != nil {
+		writeOIDCError(w, http.StatusInternalServerError, "server_error", err.Error())
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeOIDCJSON(w, http.StatusOK, result)
+}
+
+// exchangeCode consumes the authorization code and verifies the PKCE code verifier
+func (p *oidcProvider) exchangeCode(client OIDCClient, form url.Values) (grant *oidcGrant, err error) {
+	code := form.Get("code")
+	p.mu.Lock()
+	grant = p.codes[code]
+	delete(p.codes, code)
+	p.mu.Unlock()
+
+	switch {
+	case grant == nil || time.Now().After(grant.expires):
+		err = errors.New("invalid or expired code")
+	case grant.clientID != client.ID:
+		err = errors.New("the code was issued to another client")
+	case grant.redirectURI != form.Get("redirect_uri"):
+		err = errors.New("redirect_uri does not match")
+	case grant.challenge != "" && !verifyCodeChallenge(grant.challenge, grant.challengeMethod, form.Get("code_verifier")):
+		err = errors.New("invalid code_verifier")
+	}
+	return
+}
+
+func verifyCodeChallenge(challenge, method, verifier string) bool {
+	if verifier == "" {
+		return false
+	}
+	if method == "S256" {
+		hash := sha256.Sum256([]byte(verifier))
+		verifier = base64.RawURLEncoding.EncodeToString(hash[:])
+	}
+	return subtle.ConstantTimeCompare([]byte(challenge), []byte(verifier)) == 1
+}
+
+// issueTokens signs the access token, and the ID token and refresh token if there is a user
+func (p *oidcProvider) issueTokens(req *http.Request, grant *oidcGrant) (result map[string]interface{}, err error) {
+	now := time.Now()
+	claims := map[string]interface{}{
+		"iss":       p.issuer(req),
+		"aud":       grant.clientID,
+		"iat":       now.Unix(),
+		"exp":       now.Add(p.ttl).Unix(),
+		"client_id": grant.clientID,
+		"sub":       grant.clientID,
+	}
+	if grant.scope != "" {
+		claims["scope"] = grant.scope
+	}
+	if grant.user != nil {
+		claims["sub"] = grant.user.Username
+	}
+
+	var accessToken string
+	if accessToken, err = p.sign(claims); err != nil {
+		return
+	}
+	result = map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   int64(p.ttl.Seconds()),
+	}
+	if grant.scope != "" {
+		result["scope"] = grant.scope
+	}
+	if grant.user == nil {
+		return
+	}
+
+	if slices.Contains(strings.Fields(grant.scope), "openid") {
+		idClaims := userClaims(grant.user)
+		for _, k := range []string{"iss", "aud", "iat", "exp"} {
+			idClaims[k] = claims[k]
+		}
+		if grant.nonce != "" {
+			idClaims["nonce"] = grant.nonce
+		}
+		if result["id_token"], err = p.sign(idClaims); err != nil {
+			return
+		}
+	}
+
+	refreshToken := rand.Text()
+	p.mu.Lock()
+	p.refreshTokens[refreshToken] = &oidcGrant{
+		clientID: grant.clientID,
+		scope:    grant.scope,
+		user:     grant.user,
+	}
+	p.mu.Unlock()
+	result["refresh_token"] = refreshToken
+	return
+}
+
+func (p *oidcProvider) userinfo(w http.ResponseWriter, req *http.Request) {
+	token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		token = req.FormValue("access_token")
+	}
+
+	claims, err := p.verify(token)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		writeOIDCError(w, http.StatusUnauthorized, "invalid_token", err.Error())
+		return
+	}
+
+	sub, _ := claims["sub"].(string)
+	info := map[string]interface{}{"sub": sub}
+	if user := p.user(sub); user != nil {
+		info = userClaims(user)
+	}
+	writeOIDCJSON(w, http.StatusOK, info)
+}
+
+func userClaims(user *OIDCUser) (claims map[string]interface{}) {
+	claims = map[string]interface{}{
+		"sub":                user.Username,
+		"name":               user.Username,
+		"preferred_username": user.Username,
+	}
+	for k, v := range user.Claims {
+		claims[k] = v
+	}
+	return
+}
+
+// client returns the configured client, any client is accepted if there are no clients
+func (p *oidcProvider) client(id string) (client OIDCClient, ok bool) {
+	if id == "" {
+		return
+	}
+	if len(p.config.Clients) == 0 {
+		return OIDCClient{ID: id}, true
+	}
+	for _, client = range p.config.Clients {
+		if client.ID == id {
+			ok = true
+			return
+		}
+	}
+	return
+}
+
+func (p *oidcProvider) user(username string) *OIDCUser {
+	for i := range p.config.Users {
+		if p.config.Users[i].Username == username {
+			return &p.config.Users[i]
+		}
+	}
+	return nil
+}
+
+// sign generates a RS256 JWT
+func (p *oidcProvider) sign(claims map[string]interface{}) (token string, err error) {
+	var header, payload []byte
+	if header, err = json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": p.keyID}); err != nil {
+		return
+	}
+	if payload, err = json.Marshal(claims); err != nil {
+		return
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	var signature []byte
+	if signature, err = rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:]); err == nil {
+		token = signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+	}
+	return
+}
+
+// verify checks the signature and the expiration of the JWT
+func (p *oidcProvider) verify(token string) (claims map[string]interface{}, err error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		err = errors.New("malformed token")
+		return
+	}
+
+	var signature, payload []byte
+	if signature, err = base64.RawURLEncoding.DecodeString(parts[2]); err != nil {
+		err = fmt.Errorf("malformed signature: %v", err)
+		return
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err = rsa.VerifyPKCS1v15(&p.key.PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+		err = errors.New("invalid signature")
+		return
+	}
+	if payload, err = base64.RawURLEncoding.DecodeString(parts[1]); err != nil {
+		err = fmt.Errorf("malformed payload: %v", err)
+		return
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return
+	}
+	if exp, ok := claims["exp"].(float64); !ok || time.Now().Unix() > int64(exp) {
+		err = errors.New("token is expired")
+	}
+	return
+}
+
+func writeOIDCJSON(w http.ResponseWriter, status int, data interface{}) {
+	body, _ := json.Marshal(data)
+	w.Header().Set(util.ContentType, util.JSON)
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+func writeOIDCError(w http.ResponseWriter, status int, code, description string) {
+	writeOIDCJSON(w, status, map[string]string{
+		"error":             code,
+		"error_description": description,
+	})
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const oidcConfig = `oidc:
+  clients:
+    - id: web
+      secret: secret
+      redirectURIs:
+        - http://localhost/callback
+    - id: spa
+  users:
+    - username: admin
+      password: admin
+      claims:
+        email: admin@example.com
+        groups:
+          - admin`
+
+func TestOIDCProvider(t *testing.T) {
+	server := NewInMemoryServer(context.Background(), 0)
+	handler, err := server.SetupHandler(NewInMemoryReader(oidcConfig), "/mock")
+	assert.NoError(t, err)
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	issuer := srv.URL + "/mock/oidc"
+
+	getJSON := func(resp *http.Response, err error) (data map[string]interface{}, code int) {
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&data))
+		return data, resp.StatusCode
+	}
+	token := func(form url.Values) (map[string]interface{}, int) {
+		req, _ := http.NewRequest(http.MethodPost, issuer+"/token", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth("web", "secret")
+		return getJSON(client.Do(req))
+	}
+
+	t.Run("discovery", func(t *testing.T) {
+		data, code := getJSON(client.Get(issuer + "/.well-known/openid-configuration"))
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, issuer, data["issuer"])
+		assert.Equal(t, issuer+"/keys", data["jwks_uri"])
+	})
+
+	verifier := "a-long-enough-code-verifier-for-the-pkce-flow"
+	hash := sha256.Sum256([]byte(verifier))
+	authorize := func(t *testing.T, clientID, challenge string) *url.URL {
+		resp, err := client.PostForm(issuer+"/auth", url.Values{
+			"client_id":             {clientID},
+			"redirect_uri":          {"http://localhost/callback"},
+			"response_type":         {"code"},
+			"scope":                 {"openid email"},
+			"state":                 {"xyz"},
+			"nonce":                 {"n-1"},
+			"code_challenge":        {challenge},
+			"code_challenge_method": {"S256"},
+			"username":              {"admin"},
+			"password":              {"admin"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		location, err := url.Parse(resp.Header.Get("Location"))
+		assert.NoError(t, err)
+		return location
+	}
+
+	t.Run("login form", func(t *testing.T) {
+		resp, err := client.Get(issuer + "/auth?client_id=web&response_type=code&redirect_uri=http://localhost/callback")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, err = client.Get(issuer + "/auth?client_id=web&response_type=code&redirect_uri=http://other/callback")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp, err = client.PostForm(issuer+"/auth?client_id=web&response_type=code&redirect_uri=http://localhost/callback",
+			url.Values{"username": {"admin"}, "password": {"wrong"}})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("public client requires PKCE", func(t *testing.T) {
+		location := authorize(t, "spa", "")
+		assert.Equal(t, "invalid_request", location.Query().Get("error"))
+		assert.Equal(t, "xyz", location.Query().Get("state"))
+	})
+
+	var refreshToken string
+	t.Run("authorization code with PKCE", func(t *testing.T) {
+		location := authorize(t, "web", base64.RawURLEncoding.EncodeToString(hash[:]))
+		assert.Equal(t, "xyz", location.Query().Get("state"))
+		data, code := token(url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {location.Query().Get("code")},
+			"redirect_uri":  {"http://localhost/callback"},
+			"code_verifier": {"wrong"},
+		})
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "invalid_grant", data["error"])
+
+		location = authorize(t, "web", base64.RawURLEncoding.EncodeToString(hash[:]))
+		form := url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {location.Query().Get("code")},
+			"redirect_uri":  {"http://localhost/callback"},
+			"code_verifier": {verifier},
+		}
+		data, code = token(form)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "Bearer", data["token_type"])
+
+		claims := verifyJWT(t, client, issuer, data["id_token"].(string))
+		assert.Equal(t, issuer, claims["iss"])
+		assert.Equal(t, "web", claims["aud"])
+		assert.Equal(t, "admin", claims["sub"])
+		assert.Equal(t, "n-1", claims["nonce"])
+		assert.Equal(t, "admin@example.com", claims["email"])
+
+		req, _ := http.NewRequest(http.MethodGet, issuer+"/userinfo", nil)
+		req.Header.Set("Authorization", "Bearer "+data["access_token"].(string))
+		info, code := getJSON(client.Do(req))
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "admin@example.com", info["email"])
+		assert.Equal(t, []interface{}{"admin"}, info["groups"])
+
+		refreshToken = data["refresh_token"].(string)
+
+		// the code is used only once
+		_, code = token(form)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("refresh token", func(t *testing.T) {
+		data, code := token(url.Values{"grant_type": {"refresh_token"}, "refresh_token": {refreshToken}})
+		assert.Equal(t, http.StatusOK, code)
+		assert.NotEmpty(t, data["access_token"])
+		assert.NotEqual(t, refreshToken, data["refresh_token"])
+
+		_, code = token(url.Values{"grant_type": {"refresh_token"}, "refresh_token": {refreshToken}})
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("client credentials", func(t *testing.T) {
+		data, code := token(url.Values{"grant_type": {"client_credentials"}, "scope": {"api"}})
+		assert.Equal(t, http.StatusOK, code)
+		assert.Nil(t, data["refresh_token"])
+		claims := verifyJWT(t, client, issuer, data["access_token"].(string))
+		assert.Equal(t, "web", claims["sub"])
+		assert.Equal(t, "api", claims["scope"])
+
+		data, code = getJSON(client.PostForm(issuer+"/token", url.Values{
+			"grant_type":    {"client_credentials"},
+			"client_id":     {"web"},
+			"client_secret": {"wrong"},
+		}))
+		assert.Equal(t, http.StatusUnauthorized, code)
+		assert.Equal(t, "invalid_client", data["error"])
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, issuer+"/userinfo", nil)
+		req.Header.Set("Authorization", "Bearer invalid")
+		_, code := getJSON(client.Do(req))
+		assert.Equal(t, http.StatusUnauthorized, code)
+	})
+}
+
+func TestOIDCLoginHint(t *testing.T) {
+	server := NewInMemoryServer(context.Background(), 0)
+	handler, err := server.SetupHandler(NewInMemoryReader(`oidc:
+  path: /api/dex
+  users:
+    - username: admin`), "/")
+	assert.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet,
+		"/api/dex/auth?client_id=any&response_type=code&scope=openid&login_hint=admin&redirect_uri=http://localhost/callback&code_challenge=abc", nil))
+	assert.Equal(t, http.StatusFound, w.Code)
+	location, err := url.Parse(w.Header().Get("Location"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, location.Query().Get("code"))
+}
+
+// verifyJWT verifies the token with the key from the JWKS endpoint
+func verifyJWT(t *testing.T, client *http.Client, issuer, token string) (claims map[string]interface{}) {
+	resp, err := client.Get(issuer + "/keys")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	var jwks struct {
+		Keys []struct {
+			N string `json:"n"`
+			E string `json:"e"`
+		} `json:"keys"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&jwks))
+	assert.Len(t, jwks.Keys, 1)
+
+	n, _ := base64.RawURLEncoding.DecodeString(jwks.Keys[0].N)
+	e, _ := base64.RawURLEncoding.DecodeString(jwks.Keys[0].E)
+	key := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+
+	parts := strings.Split(token, ".")
+	assert.Len(t, parts, 3)
+	signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	assert.NoError(t, rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature))
+
+	payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	assert.NoError(t, json.Unmarshal(payload, &claims))
+	return
+}
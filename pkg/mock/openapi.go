@@ -0,0 +1,665 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	yamlconv "github.com/ghodss/yaml"
+	"github.com/gorilla/mux"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+const (
+	// maxRefDepth stops resolving the recursive schemas
+	maxRefDepth = 8
+	// headerPrefer selects the response by the status code, such as: Prefer: code=404
+	headerPrefer = "Prefer"
+)
+
+var openAPIMethods = []string{
+	http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete,
+	http.MethodOptions, http.MethodHead, http.MethodPatch,
+}
+
+// isOpenAPIDocument checks if it is an OpenAPI 3 or Swagger 2 document
+func isOpenAPIDocument(data []byte) bool {
+	doc := struct {
+		OpenAPI string `json:"openapi"`
+		Swagger string `json:"swagger"`
+	}{}
+	if jsonData, err := yamlconv.YAMLToJSON(data); err == nil && json.Unmarshal(jsonData, &doc) == nil {
+		return doc.OpenAPI != "" || doc.Swagger != ""
+	}
+	return false
+}
+
+type openAPIDocument struct {
+	raw map[string]interface{}
+	v2  bool
+}
+
+type openAPIOperation struct {
+	method     string
+	path       string
+	parameters []openAPIParameter
+	body       *openAPIBody
+	responses  map[string]openAPIResponse
+	// produces is the content types of the Swagger 2 responses
+	produces []string
+	v2       bool
+}
+
+type openAPIParameter struct {
+	name     string
+	in       string
+	required bool
+	schema   map[string]interface{}
+	compiled *gojsonschema.Schema
+}
+
+type openAPIBody struct {
+	required bool
+	compiled *gojsonschema.Schema
+}
+
+type openAPIResponse struct {
+	raw map[string]interface{}
+}
+
+func loadOpenAPI(config *OpenAPI) (doc *openAPIDocument, err error) {
+	data := config.data
+	if len(data) == 0 {
+		if data, err = os.ReadFile(config.File); err != nil {
+			return
+		}
+	}
+
+	var jsonData []byte
+	if jsonData, err = yamlconv.YAMLToJSON(data); err != nil {
+		return
+	}
+
+	doc = &openAPIDocument{raw: map[string]interface{}{}}
+	if err = json.Unmarshal(jsonData, &doc.raw); err == nil {
+		_, doc.v2 = doc.raw["swagger"]
+	}
+	return
+}
+
+// basePath is the path of the first server, or the basePath of Swagger 2
+func (d *openAPIDocument) basePath() string {
+	if d.v2 {
+		basePath, _ := d.raw["basePath"].(string)
+		return strings.TrimRight(basePath, "/")
+	}
+
+	servers, _ := d.raw["servers"].([]interface{})
+	if len(servers) > 0 {
+		server, _ := servers[0].(map[string]interface{})
+		if rawURL, ok := server["url"].(string); ok {
+			if u, err := url.Parse(rawURL); err == nil {
+				return strings.TrimRight(u.Path, "/")
+			}
+		}
+	}
+	return ""
+}
+
+// resolve replaces the local references, and converts the OpenAPI keywords to JSON schema
+func (d *openAPIDocument) resolve(node interface{}, depth int) interface{} {
+	switch val := node.(type) {
+	case map[string]interface{}:
+		if ref, ok := val["$ref"].(string); ok {
+			if depth >= maxRefDepth {
+				return map[string]interface{}{}
+			}
+			return d.resolve(d.lookup(ref), depth+1)
+		}
+
+		result := make(map[string]interface{}, len(val))
+		for k, v := range val {
+			result[k] = d.resolve(v, depth)
+		}
+		if nullable, _ := result["nullable"].(bool); nullable {
+			if kind, ok := result["type"].(string); ok {
+				result["type"] = []interface{}{kind, "null"}
+			}
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(val))
+		for i, v := range val {
+			result[i] = d.resolve(v, depth)
+		}
+		return result
+	}
+	return node
+}
+
+// lookup finds the node by the local JSON pointer, such as: #/components/schemas/User
+func (d *openAPIDocument) lookup(ref string) (node interface{}) {
+	if !strings.HasPrefix(ref, "#/") {
+		memLogger.Info("only the local reference is supported", "ref", ref)
+		return map[string]interface{}{}
+	}
+
+	node = d.raw
+	for _, key := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		key = strings.ReplaceAll(strings.ReplaceAll(key, "~1", "/"), "~0", "~")
+		parent, ok := node.(map[string]interface{})
+		if !ok {
+			return map[string]interface{}{}
+		}
+		node = parent[key]
+	}
+	return
+}
+
+func (d *openAPIDocument) resolveMap(node interface{}) map[string]interface{} {
+	result, _ := d.resolve(node, 0).(map[string]interface{})
+	return result
+}
+
+// operations returns all the operations, the static paths are in front of the templated ones
+func (d *openAPIDocument) operations() (operations []*openAPIOperation, err error) {
+	paths, _ := d.raw["paths"].(map[string]interface{})
+	basePath := d.basePath()
+	globalProduces := toStrings(d.raw["produces"])
+
+	for apiPath, item := range paths {
+		pathItem := d.resolveMap(item)
+		for _, method := range openAPIMethods {
+			op, ok := pathItem[strings.ToLower(method)].(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			operation := &openAPIOperation{
+				method:    method,
+				path:      basePath + apiPath,
+				responses: map[string]openAPIResponse{},
+				produces:  globalProduces,
+				v2:        d.v2,
+			}
+			if produces := toStrings(op["produces"]); len(produces) > 0 {
+				operation.produces = produces
+			}
+			if err = d.loadParameters(operation, pathItem["parameters"], op["parameters"]); err != nil {
+				return
+			}
+			if !d.v2 {
+				if err = d.loadRequestBody(operation, op["requestBody"]); err != nil {
+					return
+				}
+			}
+
+			responses, _ := op["responses"].(map[string]interface{})
+			for code, resp := range responses {
+				if raw, ok := resp.(map[string]interface{}); ok {
+					operation.responses[code] = openAPIResponse{raw: raw}
+				}
+			}
+			operations = append(operations, operation)
+		}
+	}
+
+	sort.SliceStable(operations, func(i, j int) bool {
+		x, y := strings.Count(operations[i].path, "{"), strings.Count(operations[j].path, "{")
+		if x != y {
+			return x < y
+		}
+		return operations[i].path < operations[j].path
+	})
+	return
+}
+
+func (d *openAPIDocument) loadParameters(operation *openAPIOperation, pathParams, opParams interface{}) (err error) {
+	params := map[string]map[string]interface{}{}
+	var keys []string
+	for _, list := range []interface{}{pathParams, opParams} {
+		items, _ := list.([]interface{})
+		for _, item := range items {
+			param := d.resolveMap(item)
+			key := fmt.Sprintf("%v:%v", param["in"], param["name"])
+			if _, ok := params[key]; !ok {
+				keys = append(keys, key)
+			}
+			// the operation parameters override the path ones
+			params[key] = param
+		}
+	}
+
+	for _, key := range keys {
+		param := params[key]
+		name, _ := param["name"].(string)
+		in, _ := param["in"].(string)
+		required, _ := param["required"].(bool)
+
+		var schema map[string]interface{}
+		if d.v2 && in != "body" {
+			// the schema fields are in the parameter of Swagger 2
+			schema = map[string]interface{}{}
+			for k, v := range param {
+				switch k {
+				case "name", "in", "required", "description", "allowEmptyValue", "collectionFormat":
+				default:
+					schema[k] = v
+				}
+			}
+		} else {
+			schema, _ = param["schema"].(map[string]interface{})
+		}
+
+		switch in {
+		case "body":
+			operation.body = &openAPIBody{required: required}
+			operation.body.compiled, err = compileSchema(schema)
+		case "path", "query", "header":
+			parameter := openAPIParameter{name: name, in: in, required: required, schema: schema}
+			parameter.compiled, err = compileSchema(schema)
+			operation.parameters = append(operation.parameters, parameter)
+		}
+		if err != nil {
+			err = fmt.Errorf("invalid schema of the parameter %q in %s %s: %v", name, operation.method, operation.path, err)
+			return
+		}
+	}
+	return
+}
+
+func (d *openAPIDocument) loadRequestBody(operation *openAPIOperation, node interface{}) (err error) {
+	body := d.resolveMap(node)
+	if body == nil {
+		return
+	}
+
+	content, _ := body["content"].(map[string]interface{})
+	mediaType, _ := content[jsonContentType(content)].(map[string]interface{})
+	schema, _ := mediaType["schema"].(map[string]interface{})
+
+	required, _ := body["required"].(bool)
+	operation.body = &openAPIBody{required: required}
+	if operation.body.compiled, err = compileSchema(schema); err != nil {
+		err = fmt.Errorf("invalid schema of the request body in %s %s: %v", operation.method, operation.path, err)
+	}
+	return
+}
+
+func compileSchema(schema map[string]interface{}) (*gojsonschema.Schema, error) {
+	if len(schema) == 0 {
+		return nil, nil
+	}
+	return gojsonschema.NewSchema(gojsonschema.NewGoLoader(schema))
+}
+
+// jsonContentType returns the JSON content type, or the first one
+func jsonContentType(content map[string]interface{}) (contentType string) {
+	keys := make([]string, 0, len(content))
+	for key := range content {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if strings.Contains(key, "json") {
+			return key
+		}
+	}
+	if len(keys) > 0 {
+		contentType = keys[0]
+	}
+	return
+}
+
+func toStrings(node interface{}) (result []string) {
+	items, _ := node.([]interface{})
+	for _, item := range items {
+		if val, ok := item.(string); ok {
+			result = append(result, val)
+		}
+	}
+	return
+}
+
+// validate checks the request against the parameter and body schemas
+func (o *openAPIOperation) validate(req *http.Request, body []byte) (errs []string) {
+	vars := mux.Vars(req)
+	query := req.URL.Query()
+	for _, param := range o.parameters {
+		var value string
+		var exists bool
+		switch param.in {
+		case "path":
+			value, exists = vars[param.name]
+		case "query":
+			value, exists = query.Get(param.name), query.Has(param.name)
+		case "header":
+			value, exists = req.Header.Get(param.name), len(req.Header.Values(param.name)) > 0
+		}
+
+		if !exists {
+			if param.required {
+				errs = append(errs, fmt.Sprintf("%s parameter %q is required", param.in, param.name))
+			}
+			continue
+		}
+		if param.compiled == nil {
+			continue
+		}
+
+		typed, err := convertParameter(value, param.schema)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s parameter %q: %v", param.in, param.name, err))
+			continue
+		}
+		errs = append(errs, validateSchema(param.compiled, gojsonschema.NewGoLoader(typed),
+			fmt.Sprintf("%s parameter %q", param.in, param.name))...)
+	}
+
+	if o.body != nil {
+		if len(body) == 0 {
+			if o.body.required {
+				errs = append(errs, "request body is required")
+			}
+		} else if o.body.compiled != nil {
+			if !json.Valid(body) {
+				errs = append(errs, "request body is not a valid JSON")
+			} else {
+				errs = append(errs, validateSchema(o.body.compiled, gojsonschema.NewBytesLoader(body), "request body")...)
+			}
+		}
+	}
+	return
+}
+
+func validateSchema(schema *gojsonschema.Schema, value gojsonschema.JSONLoader, subject string) (errs []string) {
+	result, err := schema.Validate(value)
+	if err != nil {
+		return []string{fmt.Sprintf("%s: %v", subject, err)}
+	}
+	for _, desc := range result.Errors() {
+		errs = append(errs, fmt.Sprintf("%s: %s", subject, desc.String()))
+	}
+	return
+}
+
+// convertParameter converts the string value to the type of the schema
+func convertParameter(value string, schema map[string]interface{}) (result interface{}, err error) {
+	kind, _ := schema["type"].(string)
+	switch kind {
+	case "integer":
+		if result, err = strconv.ParseInt(value, 10, 64); err != nil {
+			err = fmt.Errorf("%q is not an integer", value)
+		}
+	case "number":
+		if result, err = strconv.ParseFloat(value, 64); err != nil {
+			err = fmt.Errorf("%q is not a number", value)
+		}
+	case "boolean":
+		if result, err = strconv.ParseBool(value); err != nil {
+			err = fmt.Errorf("%q is not a boolean", value)
+		}
+	case "array":
+		items, _ := schema["items"].(map[string]interface{})
+		var values []interface{}
+		for _, item := range strings.Split(value, ",") {
+			var typed interface{}
+			if typed, err = convertParameter(item, items); err != nil {
+				return
+			}
+			values = append(values, typed)
+		}
+		result = values
+	default:
+		result = value
+	}
+	return
+}
+
+// selectResponse returns the preferred one, the first successful one, or the default one
+func (o *openAPIOperation) selectResponse(req *http.Request) (code int, resp openAPIResponse) {
+	if prefer := req.Header.Get(headerPrefer); strings.HasPrefix(prefer, "code=") {
+		key := strings.TrimPrefix(prefer, "code=")
+		if found, ok := o.responses[key]; ok {
+			return openAPIStatusCode(key), found
+		}
+	}
+
+	codes := make([]string, 0, len(o.responses))
+	for key := range o.responses {
+		codes = append(codes, key)
+	}
+	sort.Strings(codes)
+	for _, key := range codes {
+		if strings.HasPrefix(key, "2") {
+			return openAPIStatusCode(key), o.responses[key]
+		}
+	}
+	if found, ok := o.responses["default"]; ok {
+		return http.StatusOK, found
+	}
+	if len(codes) > 0 {
+		return openAPIStatusCode(codes[0]), o.responses[codes[0]]
+	}
+	return http.StatusOK, openAPIResponse{}
+}
+
+// openAPIStatusCode converts the key of the responses to a status code, the range like 2XX is taken as 200
+func openAPIStatusCode(key string) int {
+	if len(key) == 3 && strings.EqualFold(key[1:], "XX") {
+		key = key[:1] + "00"
+	}
+	if code, err := strconv.Atoi(key); err == nil && code >= 100 && code <= 599 {
+		return code
+	}
+	return http.StatusOK
+}
+
+// example returns the content type and the example of the response, it is generated from the schema if no example
+func (d *openAPIDocument) example(operation *openAPIOperation, resp openAPIResponse) (contentType string, example interface{}, ok bool) {
+	raw := d.resolveMap(resp.raw)
+	if operation.v2 {
+		contentType = "application/json"
+		if len(operation.produces) > 0 {
+			contentType = operation.produces[0]
+		}
+		if examples, exist := raw["examples"].(map[string]interface{}); exist {
+			if example, ok = examples[contentType]; ok {
+				return
+			}
+		}
+		if schema, exist := raw["schema"].(map[string]interface{}); exist {
+			return contentType, generateExample(schema, 0), true
+		}
+		return
+	}
+
+	content, _ := raw["content"].(map[string]interface{})
+	if contentType = jsonContentType(content); contentType == "" {
+		return
+	}
+	mediaType, _ := content[contentType].(map[string]interface{})
+	if example, ok = mediaType["example"]; ok {
+		return
+	}
+	if examples, exist := mediaType["examples"].(map[string]interface{}); exist && len(examples) > 0 {
+		names := make([]string, 0, len(examples))
+		for name := range examples {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		if item, isMap := examples[names[0]].(map[string]interface{}); isMap {
+			if example, ok = item["value"]; ok {
+				return
+			}
+		}
+	}
+	if schema, exist := mediaType["schema"].(map[string]interface{}); exist {
+		return contentType, generateExample(schema, 0), true
+	}
+	return
+}
+
+// generateExample generates a sample value from the JSON schema
+func generateExample(schema map[string]interface{}, depth int) interface{} {
+	if depth > maxRefDepth {
+		return nil
+	}
+	for _, key := range []string{"example", "default"} {
+		if val, ok := schema[key]; ok {
+			return val
+		}
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok && len(enum) > 0 {
+		return enum[0]
+	}
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		result := map[string]interface{}{}
+		for _, item := range allOf {
+			if sub, isMap := item.(map[string]interface{}); isMap {
+				if obj, isObj := generateExample(sub, depth+1).(map[string]interface{}); isObj {
+					for k, v := range obj {
+						result[k] = v
+					}
+				}
+			}
+		}
+		return result
+	}
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if items, ok := schema[key].([]interface{}); ok && len(items) > 0 {
+			if sub, isMap := items[0].(map[string]interface{}); isMap {
+				return generateExample(sub, depth+1)
+			}
+		}
+	}
+
+	kind, _ := schema["type"].(string)
+	if kinds, ok := schema["type"].([]interface{}); ok && len(kinds) > 0 {
+		kind, _ = kinds[0].(string)
+	}
+	if _, ok := schema["properties"].(map[string]interface{}); ok && kind == "" {
+		kind = "object"
+	}
+
+	switch kind {
+	case "object":
+		result := map[string]interface{}{}
+		properties, _ := schema["properties"].(map[string]interface{})
+		for name, property := range properties {
+			if sub, ok := property.(map[string]interface{}); ok {
+				result[name] = generateExample(sub, depth+1)
+			}
+		}
+		return result
+	case "array":
+		items, _ := schema["items"].(map[string]interface{})
+		return []interface{}{generateExample(items, depth+1)}
+	case "integer", "number":
+		if minimum, ok := schema["minimum"]; ok {
+			return minimum
+		}
+		return 0
+	case "boolean":
+		return true
+	case "string":
+		switch schema["format"] {
+		case "date-time":
+			return "2006-01-02T15:04:05Z"
+		case "date":
+			return "2006-01-02"
+		case "email":
+			return "user@example.com"
+		case "uuid":
+			return "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+		case "uri", "url":
+			return "https://example.com"
+		}
+		return "string"
+	}
+	return nil
+}
+
+type openAPIValidationResult struct {
+	Message string   `json:"message"`
+	Errors  []string `json:"errors"`
+}
+
+// startOpenAPI serves all the operations of the OpenAPI document, the items registered before win
+func (s *inMemoryServer) startOpenAPI(config *OpenAPI) (err error) {
+	var doc *openAPIDocument
+	if doc, err = loadOpenAPI(config); err != nil {
+		err = fmt.Errorf("failed to load the OpenAPI document: %v", err)
+		return
+	}
+
+	var operations []*openAPIOperation
+	if operations, err = doc.operations(); err != nil {
+		return
+	}
+
+	memLogger.Info("start to run all the APIs from OpenAPI", "count", len(operations))
+	for _, operation := range operations {
+		handler := s.openAPIHandler(doc, operation, config.SkipValidation)
+		name := fmt.Sprintf("openapi %s %s", operation.method, operation.path)
+		if route := s.mux.GetRoute(name); route != nil {
+			route.HandlerFunc(handler)
+		} else {
+			s.mux.NewRoute().Name(name).Methods(operation.method).Path(operation.path).HandlerFunc(handler)
+		}
+	}
+	return
+}
+
+func (s *inMemoryServer) openAPIHandler(doc *openAPIDocument, operation *openAPIOperation, skipValidation bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		s.metrics.RecordRequest(req.URL.Path)
+		if !skipValidation {
+			if errs := operation.validate(req, readBody(req)); len(errs) > 0 {
+				data, _ := json.Marshal(openAPIValidationResult{Message: "invalid request", Errors: errs})
+				w.Header().Set(util.ContentType, util.JSON)
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write(data)
+				return
+			}
+		}
+
+		code, resp := operation.selectResponse(req)
+		contentType, example, ok := doc.example(operation, resp)
+		if !ok || req.Method == http.MethodHead {
+			w.WriteHeader(code)
+			return
+		}
+
+		var data []byte
+		if text, isText := example.(string); isText && !strings.Contains(contentType, "json") {
+			data = []byte(text)
+		} else {
+			data, _ = json.Marshal(example)
+		}
+		w.Header().Set(util.ContentType, contentType)
+		w.WriteHeader(code)
+		_, _ = w.Write(data)
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenAPIMock(t *testing.T) {
+	server := NewInMemoryServer(context.Background(), 0)
+	handler, err := server.SetupHandler(NewInMemoryReader(`openAPI:
+  file: testdata/openapi.yaml
+items:
+  - name: override
+    request:
+      path: /api/v1/pets/{id}
+      header:
+        X-Request-Id: override
+    response:
+      body: overridden`), "/mock")
+	assert.NoError(t, err)
+
+	request := func(method, path, body string, header map[string]string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(method, "/mock"+path, bytes.NewBufferString(body))
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("generate from the schema", func(t *testing.T) {
+		w := request(http.MethodGet, "/api/v1/pets", "", nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+		var pets []map[string]interface{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &pets))
+		if assert.Len(t, pets, 1) {
+			assert.Equal(t, "cat", pets[0]["tag"])
+			assert.Equal(t, "2006-01-02", pets[0]["birthday"])
+			assert.Equal(t, "user@example.com", pets[0]["owner"].(map[string]interface{})["email"])
+		}
+	})
+
+	t.Run("example", func(t *testing.T) {
+		w := request(http.MethodGet, "/api/v1/pets/mine", "", nil)
+		assert.JSONEq(t, `{"id": 100, "name": "mine"}`, w.Body.String())
+
+		w = request(http.MethodPost, "/api/v1/pets", `{"name": "dog"}`, nil)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.JSONEq(t, `{"id": 1, "name": "dog"}`, w.Body.String())
+	})
+
+	t.Run("the range of status code", func(t *testing.T) {
+		w := request(http.MethodPut, "/api/v1/pets/mine", "", nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"id": 100, "name": "updated"}`, w.Body.String())
+
+		w = request(http.MethodDelete, "/api/v1/pets/mine", "", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "not allowed", w.Body.String())
+	})
+
+	t.Run("validate the request", func(t *testing.T) {
+		w := request(http.MethodGet, "/api/v1/pets?limit=0", "", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `query parameter \"limit\"`)
+
+		w = request(http.MethodGet, "/api/v1/pets?limit=abc", "", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = request(http.MethodPost, "/api/v1/pets", `{"tag": "fish"}`, nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		result := openAPIValidationResult{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+		assert.Len(t, result.Errors, 2, "name is required, and tag is not in the enum")
+
+		w = request(http.MethodPost, "/api/v1/pets", "", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "request body is required")
+
+		w = request(http.MethodGet, "/api/v1/pets/abc", "", map[string]string{"X-Request-Id": "1"})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = request(http.MethodGet, "/api/v1/pets/1", "", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `header parameter \"X-Request-Id\" is required`)
+	})
+
+	t.Run("prefer the status code", func(t *testing.T) {
+		w := request(http.MethodGet, "/api/v1/pets/1", "", map[string]string{"X-Request-Id": "1", "Prefer": "code=404"})
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "no such pet", w.Body.String())
+	})
+
+	t.Run("override by the item", func(t *testing.T) {
+		w := request(http.MethodGet, "/api/v1/pets/1", "", map[string]string{"X-Request-Id": "override"})
+		assert.Equal(t, "overridden", w.Body.String())
+	})
+
+	t.Run("unknown API", func(t *testing.T) {
+		w := request(http.MethodDelete, "/api/v1/pets", "", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestSwaggerMock(t *testing.T) {
+	server := NewInMemoryServer(context.Background(), 0)
+	handler, err := server.SetupHandler(NewLocalFileReader("testdata/swagger.json"), "/mock")
+	assert.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/mock/v2/users?dryRun=true", bytes.NewBufferString(`{"name": "rick"}`)))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"name": "rick", "age": 70}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/mock/v2/users?dryRun=yes", bytes.NewBufferString(`{"name": ""}`)))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	result := openAPIValidationResult{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Len(t, result.Errors, 2)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/mock/v2/users", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"name": "string", "age": 0}]`, w.Body.String())
+}
+
+func TestGenerateExample(t *testing.T) {
+	assert.Equal(t, map[string]interface{}{"a": "string", "b": true}, generateExample(map[string]interface{}{
+		"allOf": []interface{}{
+			map[string]interface{}{"properties": map[string]interface{}{"a": map[string]interface{}{"type": "string"}}},
+			map[string]interface{}{"properties": map[string]interface{}{"b": map[string]interface{}{"type": "boolean"}}},
+		},
+	}, 0))
+	assert.Equal(t, 1.5, generateExample(map[string]interface{}{"oneOf": []interface{}{
+		map[string]interface{}{"type": "number", "minimum": 1.5},
+	}}, 0))
+	assert.True(t, isOpenAPIDocument([]byte("swagger: '2.0'")))
+	assert.False(t, isOpenAPIDocument([]byte("items: []")))
+}
@@ -88,6 +88,11 @@ func (r *inMemoryReader) Write(data []byte) {
 
 func validateAndParse(data []byte) (server *Server, err error) {
 	server = &Server{}
+	if isOpenAPIDocument(data) {
+		// serve the OpenAPI document directly
+		server.OpenAPI = &OpenAPI{data: data}
+		return
+	}
 	if len(data) > 0 {
 		err = yaml.Unmarshal(data, server)
 		err = errors.Join(err, docs.Validate(data, docs.MockSchema))
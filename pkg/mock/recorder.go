@@ -0,0 +1,339 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/tidwall/gjson"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ProxyModeRecord forwards the requests, and records the traffic as mock items
+	ProxyModeRecord = "record"
+	// ProxyModeReplay serves the recorded mock items instead of forwarding
+	ProxyModeReplay = "replay"
+)
+
+// the headers which are never recorded
+var ignoredRecordHeaders = []string{
+	"Connection", "Content-Length", "Date", "Keep-Alive", "Transfer-Encoding", headerMockServer,
+}
+
+type proxyRecorder struct {
+	record ProxyRecord
+	target string
+	mu     sync.Mutex
+}
+
+func newProxyRecorder(proxy *Proxy) (recorder *proxyRecorder, err error) {
+	if proxy.Record == nil || proxy.Record.File == "" {
+		err = fmt.Errorf("record file is required in the %s mode of proxy %q", proxy.Mode, proxy.Path)
+		return
+	}
+	recorder = &proxyRecorder{record: *proxy.Record, target: proxy.Target}
+	return
+}
+
+// loadRecordedItems returns the recorded items of the replay proxies
+func loadRecordedItems(proxies []Proxy) (items []Item, err error) {
+	for i := range proxies {
+		if proxies[i].Mode != ProxyModeReplay {
+			continue
+		}
+
+		var recorder *proxyRecorder
+		if recorder, err = newProxyRecorder(&proxies[i]); err != nil {
+			return
+		}
+
+		var server *Server
+		if server, err = recorder.readItems(); err != nil {
+			return
+		}
+		memLogger.Info("replay the recorded items", "file", recorder.record.File, "count", len(server.Items))
+		items = append(items, server.Items...)
+	}
+	return
+}
+
+func (r *proxyRecorder) readItems() (server *Server, err error) {
+	var data []byte
+	if data, err = os.ReadFile(r.record.File); err == nil {
+		server, err = validateAndParse(data)
+	} else if errors.Is(err, os.ErrNotExist) {
+		server, err = &Server{}, nil
+	}
+	return
+}
+
+// allowHeader checks if the header should be recorded
+func (r *proxyRecorder) allowHeader(key string) bool {
+	for _, ignored := range ignoredRecordHeaders {
+		if strings.EqualFold(ignored, key) {
+			return false
+		}
+	}
+	for _, deny := range r.record.DenyHeaders {
+		if strings.EqualFold(deny, key) {
+			return false
+		}
+	}
+	if len(r.record.AllowHeaders) == 0 {
+		return true
+	}
+	for _, allow := range r.record.AllowHeaders {
+		if strings.EqualFold(allow, key) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *proxyRecorder) filterHeader(header http.Header) (result map[string]string) {
+	for key := range header {
+		if r.allowHeader(key) {
+			if result == nil {
+				result = map[string]string{}
+			}
+			result[key] = header.Get(key)
+		}
+	}
+	return
+}
+
+// recordedRequest is a request/response pair of the proxy
+type recordedRequest struct {
+	method       string
+	path         string
+	query        url.Values
+	header       http.Header
+	body         []byte
+	statusCode   int
+	respHeader   http.Header
+	responseBody []byte
+}
+
+func (r *recordedRequest) key() string {
+	return recordKey(r.method, r.path, r.query.Encode(), r.body)
+}
+
+// recordKey identifies a request, the requests with different bodies are recorded as different items
+func recordKey(method, path, query string, body []byte) string {
+	sum := sha256.Sum256(body)
+	return fmt.Sprintf("%s %s?%s#%s", method, path, query, hex.EncodeToString(sum[:]))
+}
+
+func itemRecordKey(item Item) string {
+	query := url.Values{}
+	for key, matcher := range item.Request.Query {
+		query.Set(key, matcher.Equals)
+	}
+	return recordKey(util.EmptyThenDefault(item.Request.Method, http.MethodGet), item.Request.Path, query.Encode(),
+		[]byte(item.Request.Body))
+}
+
+var nonWordReg = regexp.MustCompile(`[^\w]+`)
+
+// itemName generates a readable and unique name, such as: get-api-v1-users
+func itemName(method, path string, existing map[string]bool) (name string) {
+	base := strings.ToLower(method) + "-" + strings.Trim(nonWordReg.ReplaceAllString(path, "-"), "-")
+	base = strings.TrimSuffix(base, "-")
+	name = base
+	for i := 2; existing[name]; i++ {
+		name = fmt.Sprintf("%s-%d", base, i)
+	}
+	return
+}
+
+func (r *proxyRecorder) toResponse(req *recordedRequest) (resp Response) {
+	resp = Response{
+		StatusCode: req.statusCode,
+		Header:     r.filterHeader(req.respHeader),
+	}
+	if utf8.Valid(req.responseBody) {
+		resp.Body = string(req.responseBody)
+		resp.Encoder = "raw"
+	} else {
+		resp.Body = base64.StdEncoding.EncodeToString(req.responseBody)
+		resp.Encoder = "base64"
+	}
+	return
+}
+
+// save writes the request/response pair into the mock config, and the test suite if it is set
+func (r *proxyRecorder) save(req *recordedRequest) (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var server *Server
+	if server, err = r.readItems(); err != nil {
+		return
+	}
+
+	key := req.key()
+	names := map[string]bool{}
+	var existing *Item
+	for i, item := range server.Items {
+		names[item.Name] = true
+		if itemRecordKey(item) == key {
+			existing = &server.Items[i]
+		}
+	}
+
+	response := r.toResponse(req)
+	var name string
+	switch {
+	case existing == nil:
+		name = itemName(req.method, req.path, names)
+		item := Item{
+			Name: name,
+			Request: Request{
+				Path:   req.path,
+				Method: req.method,
+				Body:   string(req.body),
+			},
+			Response: response,
+		}
+		for k := range req.query {
+			if item.Request.Query == nil {
+				item.Request.Query = map[string]Matcher{}
+			}
+			item.Request.Query[k] = Matcher{Equals: req.query.Get(k)}
+		}
+		// match the body fields, so that the items of the different bodies could be replayed.
+		// The raw values are taken as they are compared when matching, such as: 1000000 instead of 1e+06
+		for k := range bodyFieldsExpect(req.body) {
+			if item.Request.BodyJSON == nil {
+				item.Request.BodyJSON = map[string]Matcher{}
+			}
+			path := gjson.Escape(k)
+			item.Request.BodyJSON[path] = Matcher{Equals: gjson.GetBytes(req.body, path).String()}
+		}
+		server.Items = append(server.Items, item)
+	case r.record.Dedupe || sameResponse(existing, response):
+		return
+	default:
+		// the different responses of the same request become a sequence
+		if len(existing.Responses) == 0 {
+			existing.Responses = []Response{existing.Response}
+			existing.Response = Response{}
+		}
+		existing.Responses = append(existing.Responses, response)
+	}
+
+	var data []byte
+	if data, err = yaml.Marshal(&recordedItems{Items: server.Items}); err == nil {
+		data = append([]byte("#!api-testing-mock\n"), data...)
+		err = os.WriteFile(r.record.File, data, 0644)
+	}
+
+	if err == nil && name != "" && r.record.SuiteFile != "" {
+		err = r.saveTestCase(name, req)
+	}
+	return
+}
+
+// recordedItems is the content of the record file
+type recordedItems struct {
+	Items []Item `yaml:"items"`
+}
+
+func sameResponse(item *Item, response Response) bool {
+	last := item.Response
+	if count := len(item.Responses); count > 0 {
+		last = item.Responses[count-1]
+	}
+	return last.StatusCode == response.StatusCode && last.Body == response.Body
+}
+
+func (r *proxyRecorder) saveTestCase(name string, req *recordedRequest) (err error) {
+	suite := &atest.TestSuite{}
+	if _, statErr := os.Stat(r.record.SuiteFile); statErr == nil {
+		if suite, err = atest.ParseTestSuiteFromFile(r.record.SuiteFile); err != nil {
+			return
+		}
+	} else {
+		suite.Name = "recorded"
+		suite.API = r.target
+	}
+
+	testCase := atest.TestCase{
+		Name: name,
+		Request: atest.Request{
+			API:    req.path,
+			Method: req.method,
+			Header: r.filterHeader(req.header),
+		},
+		Expect: atest.Response{
+			StatusCode:       req.statusCode,
+			BodyFieldsExpect: bodyFieldsExpect(req.responseBody),
+		},
+	}
+	if len(req.query) > 0 {
+		testCase.Request.Query = atest.SortedKeysStringMap{}
+		for k := range req.query {
+			testCase.Request.Query[k] = req.query.Get(k)
+		}
+	}
+	if len(req.body) > 0 {
+		testCase.Request.Body = atest.NewRequestBody(string(req.body))
+	}
+
+	suite.Items = append(suite.Items, testCase)
+	err = atest.SaveTestSuiteToFile(suite, r.record.SuiteFile)
+	return
+}
+
+// bodyFieldsExpect returns the top level scalar fields of the JSON object
+func bodyFieldsExpect(body []byte) (result map[string]interface{}) {
+	data := map[string]interface{}{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return
+	}
+
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		switch data[key].(type) {
+		case string, float64, bool:
+			if result == nil {
+				result = map[string]interface{}{}
+			}
+			result[key] = data[key]
+		}
+	}
+	return
+}
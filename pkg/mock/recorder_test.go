@@ -0,0 +1,185 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProxyRecordAndReplay(t *testing.T) {
+	var count int
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"name":"%s","count":%d}`, r.URL.Query().Get("name"), count)
+	}))
+	defer target.Close()
+
+	dir := t.TempDir()
+	mockFile := filepath.Join(dir, "recorded.yaml")
+	suiteFile := filepath.Join(dir, "suite.yaml")
+
+	request := func(handler http.Handler, path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/mock"+path, strings.NewReader(`{}`)))
+		return w
+	}
+
+	t.Run("record", func(t *testing.T) {
+		server := NewInMemoryServer(context.Background(), 0)
+		handler, err := server.SetupHandler(NewInMemoryReader(fmt.Sprintf(`proxies:
+  - path: /api/{part}
+    target: %s
+    mode: record
+    record:
+      file: %s
+      suiteFile: %s
+      denyHeaders: [Set-Cookie]`, target.URL, mockFile, suiteFile)), "/mock")
+		assert.NoError(t, err)
+
+		w := request(handler, "/api/users?name=rick")
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.JSONEq(t, `{"name":"rick","count":1}`, w.Body.String())
+		request(handler, "/api/users?name=rick")
+		request(handler, "/api/users?name=morty")
+
+		recorded := &Server{}
+		data, err := os.ReadFile(mockFile)
+		if assert.NoError(t, err) {
+			recorded, err = validateAndParse(data)
+			assert.NoError(t, err)
+		}
+		if assert.Len(t, recorded.Items, 2) {
+			item := recorded.Items[0]
+			assert.Equal(t, "post-api-users", item.Name)
+			assert.Equal(t, "/api/users", item.Request.Path)
+			assert.Equal(t, "rick", item.Request.Query["name"].Equals)
+			assert.Len(t, item.Responses, 2, "the different responses should be a sequence")
+			assert.Equal(t, "application/json", item.Responses[0].Header["Content-Type"])
+			assert.NotContains(t, item.Responses[0].Header, "Set-Cookie")
+
+			assert.Equal(t, "post-api-users-2", recorded.Items[1].Name)
+			assert.Equal(t, http.StatusCreated, recorded.Items[1].Response.StatusCode)
+		}
+
+		suite, err := atest.ParseTestSuiteFromFile(suiteFile)
+		if assert.NoError(t, err) && assert.Len(t, suite.Items, 2) {
+			assert.Equal(t, target.URL, suite.API)
+			assert.Equal(t, http.StatusCreated, suite.Items[0].Expect.StatusCode)
+			assert.Equal(t, map[string]interface{}{"name": "rick", "count": 1}, suite.Items[0].Expect.BodyFieldsExpect)
+		}
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		target.Close()
+
+		server := NewInMemoryServer(context.Background(), 0)
+		handler, err := server.SetupHandler(NewInMemoryReader(fmt.Sprintf(`proxies:
+  - path: /api/{part}
+    target: %s
+    mode: replay
+    record:
+      file: %s`, target.URL, mockFile)), "/mock")
+		assert.NoError(t, err)
+
+		w := request(handler, "/api/users?name=morty")
+		assert.Equal(t, http.StatusCreated, w.Code)
+		data, _ := io.ReadAll(w.Body)
+		assert.JSONEq(t, `{"name":"morty","count":3}`, string(data))
+
+		assert.JSONEq(t, `{"name":"rick","count":1}`, request(handler, "/api/users?name=rick").Body.String())
+		assert.JSONEq(t, `{"name":"rick","count":2}`, request(handler, "/api/users?name=rick").Body.String())
+	})
+
+	t.Run("missing record file", func(t *testing.T) {
+		server := NewInMemoryServer(context.Background(), 0)
+		_, err := server.SetupHandler(NewInMemoryReader(`proxies:
+  - path: /api
+    target: http://localhost
+    mode: record`), "/mock")
+		assert.ErrorContains(t, err, "record file is required")
+	})
+}
+
+func TestProxyRecordBody(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(data)
+	}))
+	defer target.Close()
+
+	mockFile := filepath.Join(t.TempDir(), "recorded.yaml")
+	config := func(mode string) Reader {
+		return NewInMemoryReader(fmt.Sprintf(`proxies:
+  - path: /api/{part}
+    target: %s
+    mode: %s
+    record:
+      file: %s
+      dedupe: true`, target.URL, mode, mockFile))
+	}
+	request := func(handler http.Handler, body string) string {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/mock/api/users", strings.NewReader(body)))
+		return w.Body.String()
+	}
+
+	handler, err := NewInMemoryServer(context.Background(), 0).SetupHandler(config(ProxyModeRecord), "/mock")
+	assert.NoError(t, err)
+	request(handler, `{"name":"rick","age":70}`)
+	request(handler, `{"name":"morty","age":14,"createdAt":1700000000}`)
+	request(handler, `{"name":"rick","age":70}`)
+
+	recorded := &Server{}
+	data, err := os.ReadFile(mockFile)
+	if assert.NoError(t, err) {
+		recorded, err = validateAndParse(data)
+		assert.NoError(t, err)
+	}
+	if assert.Len(t, recorded.Items, 2, "the requests with different bodies should not be deduplicated") {
+		assert.Equal(t, map[string]Matcher{"name": {Equals: "rick"}, "age": {Equals: "70"}}, recorded.Items[0].Request.BodyJSON)
+	}
+
+	handler, err = NewInMemoryServer(context.Background(), 0).SetupHandler(config(ProxyModeReplay), "/mock")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"morty","age":14,"createdAt":1700000000}`,
+		request(handler, `{"name":"morty","age":14,"createdAt":1700000000}`), "the large numbers are not formatted as 1.7e+09")
+	assert.JSONEq(t, `{"name":"rick","age":70}`, request(handler, `{"name":"rick","age":70}`))
+}
+
+func TestRecorderFilterHeader(t *testing.T) {
+	recorder := &proxyRecorder{record: ProxyRecord{AllowHeaders: []string{"content-type", "content-length"}}}
+	assert.Equal(t, map[string]string{"Content-Type": "text/plain"}, recorder.filterHeader(http.Header{
+		"Content-Type":   []string{"text/plain"},
+		"Content-Length": []string{"1"},
+		"Accept":         []string{"*/*"},
+	}))
+	assert.Equal(t, "get-v1-users-2", itemName(http.MethodGet, "/v1/users/", map[string]bool{"get-v1-users": true}))
+	assert.Nil(t, bodyFieldsExpect([]byte(`[1]`)))
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+var _ ScenarioManager = &inMemoryServer{}
+
+// ScenarioManager inspects and changes the states of the scenarios
+type ScenarioManager interface {
+	GetScenarios() []ScenarioState
+	SetScenarioState(name, state string) error
+	// ResetScenarios resets the given scenarios to the initial states, or all scenarios if no names given
+	ResetScenarios(names ...string) error
+}
+
+type scenarioItem struct {
+	scenario      string
+	requiredState string
+	newState      string
+}
+
+type scenarioStore struct {
+	scenarios map[string]*ScenarioState
+	items     map[string]scenarioItem
+	// counters records the matched times of the items which have responses sequence
+	counters map[string]int
+	mu       sync.RWMutex
+}
+
+func newScenarioStore() *scenarioStore {
+	return &scenarioStore{
+		scenarios: map[string]*ScenarioState{},
+		items:     map[string]scenarioItem{},
+		counters:  map[string]int{},
+	}
+}
+
+// load initializes the states of the scenarios, and validates the items against the scenarios
+func (s *scenarioStore) load(scenarios []Scenario, items []Item) (err error) {
+	states := make(map[string]*ScenarioState, len(scenarios))
+	for _, scenario := range scenarios {
+		if scenario.Name == "" {
+			return fmt.Errorf("scenario name is required")
+		}
+		if _, ok := states[scenario.Name]; ok {
+			return fmt.Errorf("scenario %q is duplicated", scenario.Name)
+		}
+
+		state := &ScenarioState{
+			Name:         scenario.Name,
+			States:       scenario.States,
+			InitialState: scenario.InitialState,
+		}
+		if state.InitialState == "" && len(state.States) > 0 {
+			state.InitialState = state.States[0]
+		}
+		if !state.valid(state.InitialState) {
+			return fmt.Errorf("initial state %q is not valid in scenario %q", state.InitialState, scenario.Name)
+		}
+		state.State = state.InitialState
+		states[scenario.Name] = state
+	}
+
+	scenarioItems := map[string]scenarioItem{}
+	for _, item := range items {
+		switch item.Sequence {
+		case "", SequenceLast, SequenceCycle:
+		default:
+			return fmt.Errorf("unsupported sequence %q of item %q", item.Sequence, item.Name)
+		}
+
+		if item.Scenario == "" {
+			if item.RequiredState != "" || item.NewState != "" {
+				return fmt.Errorf("scenario is required when the item %q has state", item.Name)
+			}
+			continue
+		}
+
+		state, ok := states[item.Scenario]
+		if !ok {
+			return fmt.Errorf("scenario %q of item %q is not found", item.Scenario, item.Name)
+		}
+		for _, itemState := range []string{item.RequiredState, item.NewState} {
+			if itemState != "" && !state.valid(itemState) {
+				return fmt.Errorf("state %q of item %q is not valid in scenario %q", itemState, item.Name, item.Scenario)
+			}
+		}
+		scenarioItems[item.Name] = scenarioItem{
+			scenario:      item.Scenario,
+			requiredState: item.RequiredState,
+			newState:      item.NewState,
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scenarios = states
+	s.items = scenarioItems
+	s.counters = map[string]int{}
+	return
+}
+
+// match returns the reason if the current state of the scenario is not the required one of the item
+func (s *scenarioStore) match(name string) (reason string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.unmatchedReason(name)
+}
+
+func (s *scenarioStore) unmatchedReason(name string) (reason string) {
+	item, ok := s.items[name]
+	if !ok || item.requiredState == "" {
+		return
+	}
+	if state, ok := s.scenarios[item.scenario]; ok && state.State != item.requiredState {
+		reason = fmt.Sprintf("scenario %q: expected state %q, got %q", item.scenario, item.requiredState, state.State)
+	}
+	return
+}
+
+// matchAndNext checks the required state of the item, then returns the index of the response in the sequence
+// and transits the state of the scenario. Both are done in one critical section, so the concurrent requests
+// cannot match the same state. Nothing changes if the reason is not empty.
+func (s *scenarioStore) matchAndNext(item *Item) (index int, reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if reason = s.unmatchedReason(item.Name); reason != "" {
+		return
+	}
+
+	if count := len(item.Responses); count > 0 {
+		index = s.counters[item.Name]
+		s.counters[item.Name]++
+		if item.Sequence == SequenceCycle {
+			index = index % count
+		} else if index >= count {
+			index = count - 1
+		}
+	}
+
+	if scenarioItem, ok := s.items[item.Name]; ok && scenarioItem.newState != "" {
+		if state, ok := s.scenarios[scenarioItem.scenario]; ok {
+			memLogger.Info("scenario state changed", "scenario", state.Name, "from", state.State, "to", scenarioItem.newState)
+			state.State = scenarioItem.newState
+		}
+	}
+	return
+}
+
+func (s *scenarioStore) GetScenarios() (result []ScenarioState) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	result = make([]ScenarioState, 0, len(s.scenarios))
+	for _, state := range s.scenarios {
+		result = append(result, *state)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return
+}
+
+func (s *scenarioStore) SetScenarioState(name, state string) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	scenario, ok := s.scenarios[name]
+	if !ok {
+		err = fmt.Errorf("scenario %q is not found", name)
+	} else if !scenario.valid(state) {
+		err = fmt.Errorf("state %q is not valid in scenario %q", state, name)
+	} else {
+		scenario.State = state
+	}
+	return
+}
+
+func (s *scenarioStore) ResetScenarios(names ...string) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(names) == 0 {
+		for name := range s.scenarios {
+			names = append(names, name)
+		}
+	}
+
+	for _, name := range names {
+		scenario, ok := s.scenarios[name]
+		if !ok {
+			err = fmt.Errorf("scenario %q is not found", name)
+			return
+		}
+		scenario.State = scenario.InitialState
+
+		for itemName, item := range s.items {
+			if item.scenario == name {
+				delete(s.counters, itemName)
+			}
+		}
+	}
+	return
+}
+
+func (s *ScenarioState) valid(state string) bool {
+	if len(s.States) == 0 {
+		return true
+	}
+	for _, item := range s.States {
+		if item == state {
+			return true
+		}
+	}
+	return false
+}
+
+// addScenarioHandler adds the endpoints to inspect and reset the scenarios
+func (s *scenarioStore) addScenarioHandler(router *mux.Router) {
+	router.HandleFunc("/_scenarios", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set(util.ContentType, util.JSON)
+		switch req.Method {
+		case http.MethodGet:
+			data, err := json.Marshal(s.GetScenarios())
+			writeResponse(w, data, err)
+		case http.MethodDelete:
+			writeScenarioResult(w, s.ResetScenarios(), s.GetScenarios())
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	router.HandleFunc("/_scenarios/{name}", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set(util.ContentType, util.JSON)
+		name := mux.Vars(req)["name"]
+		switch req.Method {
+		case http.MethodGet:
+			for _, state := range s.GetScenarios() {
+				if state.Name == name {
+					data, err := json.Marshal(state)
+					writeResponse(w, data, err)
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		case http.MethodPut:
+			state := ScenarioState{}
+			data, err := io.ReadAll(req.Body)
+			if err == nil {
+				if err = json.Unmarshal(data, &state); err == nil {
+					err = s.SetScenarioState(name, state.State)
+				}
+			}
+			writeScenarioResult(w, err, s.GetScenarios())
+		case http.MethodDelete:
+			writeScenarioResult(w, s.ResetScenarios(name), s.GetScenarios())
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func writeScenarioResult(w http.ResponseWriter, err error, states []ScenarioState) {
+	var data []byte
+	if err == nil {
+		data, err = json.Marshal(states)
+	}
+	writeResponse(w, data, err)
+}
+
+func (s *inMemoryServer) GetScenarios() []ScenarioState {
+	return s.scenarios.GetScenarios()
+}
+
+func (s *inMemoryServer) SetScenarioState(name, state string) error {
+	return s.scenarios.SetScenarioState(name, state)
+}
+
+func (s *inMemoryServer) ResetScenarios(names ...string) error {
+	return s.scenarios.ResetScenarios(names...)
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const scenarioConfig = `scenarios:
+  - name: session
+    states: [logout, login]
+items:
+  - name: login
+    scenario: session
+    newState: login
+    request:
+      path: /login
+      method: POST
+    response:
+      body: welcome
+  - name: profile
+    scenario: session
+    requiredState: login
+    request:
+      path: /profile
+    response:
+      body: rick
+  - name: profile-without-login
+    scenario: session
+    requiredState: logout
+    request:
+      path: /profile
+    response:
+      statusCode: 401
+  - name: logout
+    scenario: session
+    requiredState: login
+    newState: logout
+    request:
+      path: /logout
+      method: POST
+    response:
+      body: bye
+  - name: job
+    request:
+      path: /job
+    responses:
+      - statusCode: 202
+        body: pending
+      - statusCode: 202
+        body: pending
+      - statusCode: 200
+        body: done
+  - name: round
+    sequence: cycle
+    request:
+      path: /round
+    responses:
+      - body: one
+      - body: two`
+
+func TestScenario(t *testing.T) {
+	server := NewInMemoryServer(context.Background(), 0)
+	handler, err := server.SetupHandler(NewInMemoryReader(scenarioConfig), "/mock")
+	assert.NoError(t, err)
+
+	request := func(method, path string, body string) (int, string) {
+		req := httptest.NewRequest(method, "/mock"+path, bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		data, _ := io.ReadAll(w.Result().Body)
+		return w.Code, string(data)
+	}
+
+	t.Run("state machine", func(t *testing.T) {
+		code, _ := request(http.MethodGet, "/profile", "")
+		assert.Equal(t, http.StatusUnauthorized, code)
+
+		code, body := request(http.MethodPost, "/logout", "")
+		assert.Equal(t, http.StatusNotFound, code, body)
+
+		_, body = request(http.MethodPost, "/login", "")
+		assert.Equal(t, "welcome", body)
+
+		code, body = request(http.MethodGet, "/profile", "")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "rick", body)
+
+		_, body = request(http.MethodGet, "/_scenarios/session", "")
+		assert.JSONEq(t, `{"name":"session","state":"login","initialState":"logout","states":["logout","login"]}`, body)
+
+		_, body = request(http.MethodPost, "/logout", "")
+		assert.Equal(t, "bye", body)
+
+		code, _ = request(http.MethodGet, "/profile", "")
+		assert.Equal(t, http.StatusUnauthorized, code)
+	})
+
+	t.Run("change and reset the state", func(t *testing.T) {
+		code, _ := request(http.MethodPut, "/_scenarios/session", `{"state":"login"}`)
+		assert.Equal(t, http.StatusOK, code)
+		code, _ = request(http.MethodGet, "/profile", "")
+		assert.Equal(t, http.StatusOK, code)
+
+		code, body := request(http.MethodPut, "/_scenarios/session", `{"state":"fake"}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, body, `state "fake" is not valid`)
+
+		code, _ = request(http.MethodDelete, "/_scenarios/session", "")
+		assert.Equal(t, http.StatusOK, code)
+		code, _ = request(http.MethodGet, "/profile", "")
+		assert.Equal(t, http.StatusUnauthorized, code)
+
+		code, _ = request(http.MethodGet, "/_scenarios/fake", "")
+		assert.Equal(t, http.StatusNotFound, code)
+		code, _ = request(http.MethodDelete, "/_scenarios/fake", "")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("stick on the last response", func(t *testing.T) {
+		for _, expected := range []string{"pending", "pending", "done", "done"} {
+			_, body := request(http.MethodGet, "/job", "")
+			assert.Equal(t, expected, body)
+		}
+		code, _ := request(http.MethodGet, "/job", "")
+		assert.Equal(t, http.StatusOK, code)
+	})
+
+	t.Run("cycle the responses", func(t *testing.T) {
+		for _, expected := range []string{"one", "two", "one"} {
+			_, body := request(http.MethodGet, "/round", "")
+			assert.Equal(t, expected, body)
+		}
+	})
+
+	t.Run("only one concurrent request transits the state", func(t *testing.T) {
+		code, _ := request(http.MethodPut, "/_scenarios/session", `{"state":"login"}`)
+		assert.Equal(t, http.StatusOK, code)
+
+		const count = 20
+		codes := make(chan int, count)
+		wg := sync.WaitGroup{}
+		for i := 0; i < count; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				code, _ := request(http.MethodPost, "/logout", "")
+				codes <- code
+			}()
+		}
+		wg.Wait()
+		close(codes)
+
+		var succeed int
+		for code := range codes {
+			if code == http.StatusOK {
+				succeed++
+			} else {
+				assert.Equal(t, http.StatusNotFound, code)
+			}
+		}
+		assert.Equal(t, 1, succeed)
+	})
+
+	t.Run("reset all scenarios", func(t *testing.T) {
+		_, _ = request(http.MethodPost, "/login", "")
+		code, body := request(http.MethodDelete, "/_scenarios", "")
+		assert.Equal(t, http.StatusOK, code)
+
+		var states []ScenarioState
+		assert.NoError(t, json.Unmarshal([]byte(body), &states))
+		assert.Equal(t, []ScenarioState{{
+			Name: "session", State: "logout", InitialState: "logout", States: []string{"logout", "login"},
+		}}, states)
+	})
+}
+
+func TestScenarioStoreLoad(t *testing.T) {
+	tests := []struct {
+		name      string
+		scenarios []Scenario
+		items     []Item
+		errorMsg  string
+	}{{
+		name:      "duplicated scenario",
+		scenarios: []Scenario{{Name: "a"}, {Name: "a"}},
+		errorMsg:  `scenario "a" is duplicated`,
+	}, {
+		name:      "invalid initial state",
+		scenarios: []Scenario{{Name: "a", States: []string{"b"}, InitialState: "c"}},
+		errorMsg:  `initial state "c" is not valid`,
+	}, {
+		name:     "scenario not found",
+		items:    []Item{{Name: "item", Scenario: "a"}},
+		errorMsg: `scenario "a" of item "item" is not found`,
+	}, {
+		name:     "state without scenario",
+		items:    []Item{{Name: "item", NewState: "a"}},
+		errorMsg: `scenario is required`,
+	}, {
+		name:      "invalid item state",
+		scenarios: []Scenario{{Name: "a", States: []string{"b"}}},
+		items:     []Item{{Name: "item", Scenario: "a", RequiredState: "c"}},
+		errorMsg:  `state "c" of item "item" is not valid`,
+	}, {
+		name:     "unsupported sequence",
+		items:    []Item{{Name: "item", Sequence: "fake"}},
+		errorMsg: `unsupported sequence "fake"`,
+	}, {
+		name:      "any state is valid",
+		scenarios: []Scenario{{Name: "a"}},
+		items:     []Item{{Name: "item", Scenario: "a", NewState: "b"}},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newScenarioStore().load(tt.scenarios, tt.items)
+			if tt.errorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errorMsg)
+			}
+		})
+	}
+}
+
+func TestScenarioStoreMatchAndNext(t *testing.T) {
+	store := newScenarioStore()
+	item := Item{Name: "pay", Scenario: "order", RequiredState: "created", NewState: "paid",
+		Responses: []Response{{Body: "one"}, {Body: "two"}}}
+	assert.NoError(t, store.load([]Scenario{{Name: "order", States: []string{"created", "paid"}}}, []Item{item}))
+
+	const count = 20
+	reasons := make(chan string, count)
+	wg := sync.WaitGroup{}
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, reason := store.matchAndNext(&item)
+			reasons <- reason
+		}()
+	}
+	wg.Wait()
+	close(reasons)
+
+	var matched int
+	for reason := range reasons {
+		if reason == "" {
+			matched++
+		} else {
+			assert.Equal(t, `scenario "order": expected state "created", got "paid"`, reason)
+		}
+	}
+	assert.Equal(t, 1, matched)
+
+	// the sequence does not move forward if the state does not match
+	assert.NoError(t, store.SetScenarioState("order", "created"))
+	index, reason := store.matchAndNext(&item)
+	assert.Empty(t, reason)
+	assert.Equal(t, 1, index)
+}
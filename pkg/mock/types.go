@@ -19,22 +19,87 @@ type Object struct {
 	Name      string `yaml:"name" json:"name"`
 	InitCount *int   `yaml:"initCount" json:"initCount"`
 	Sample    string `yaml:"sample" json:"sample"`
+	// IDField is the field to identify an object, it is name by default
+	IDField string `yaml:"idField" json:"idField"`
+	// IDGenerator generates the ID if it is missing, it could be uuid or increment
+	IDGenerator string `yaml:"idGenerator" json:"idGenerator"`
+	// File is the JSON file which the data is persisted into
+	File string `yaml:"file" json:"file"`
+	// Strict enables the RESTful semantics: 201 for creating, 409 for the duplicated ID, 400 for the invalid
+	// payload, and the reserved query parameters of listing: limit, offset, cursor and sort
+	Strict bool `yaml:"strict" json:"strict"`
 }
 
 type Item struct {
-	Name     string   `yaml:"name" json:"name"`
-	Request  Request  `yaml:"request" json:"request"`
-	Response Response `yaml:"response" json:"response"`
-	Param    map[string]interface{}
+	Name     string   `yaml:"name,omitempty" json:"name"`
+	Request  Request  `yaml:"request,omitempty" json:"request"`
+	Response Response `yaml:"response,omitempty" json:"response"`
+	// Responses are returned in order, it has higher priority than Response
+	Responses []Response `yaml:"responses,omitempty" json:"responses"`
+	// Sequence is the mode of the responses, the last one is kept by default
+	Sequence string `yaml:"sequence,omitempty" json:"sequence"`
+	// Scenario is the name of the scenario which the item belongs to
+	Scenario string `yaml:"scenario,omitempty" json:"scenario"`
+	// RequiredState is the state of the scenario which is required to match the item
+	RequiredState string `yaml:"requiredState,omitempty" json:"requiredState"`
+	// NewState is the state of the scenario after the item was matched
+	NewState string `yaml:"newState,omitempty" json:"newState"`
+	// Priority decides which item wins when multiple items match the request, the higher one wins
+	Priority int `yaml:"priority,omitempty" json:"priority"`
+	// Fallback item is used only when no other item matches the request
+	Fallback bool `yaml:"fallback,omitempty" json:"fallback"`
+	// Fault overrides the global fault of the server
+	Fault *Fault                 `yaml:"fault,omitempty" json:"fault"`
+	Param map[string]interface{} `yaml:"param,omitempty"`
+}
+
+const (
+	// SequenceLast keeps returning the last response once all the responses were returned
+	SequenceLast = "last"
+	// SequenceCycle returns the responses from the first one once all the responses were returned
+	SequenceCycle = "cycle"
+)
+
+// Scenario is a state machine which the items could be gated on
+type Scenario struct {
+	Name string `yaml:"name" json:"name"`
+	// States are the valid states, any state is valid if it is empty
+	States []string `yaml:"states" json:"states"`
+	// InitialState is the first state by default
+	InitialState string `yaml:"initialState" json:"initialState"`
+}
+
+// ScenarioState represents the current state of a scenario
+type ScenarioState struct {
+	Name         string   `yaml:"name" json:"name"`
+	State        string   `yaml:"state" json:"state"`
+	InitialState string   `yaml:"initialState" json:"initialState"`
+	States       []string `yaml:"states" json:"states"`
 }
 
 type Request struct {
-	Protocol     string            `yaml:"protocol" json:"protocol"`
-	Path         string            `yaml:"path" json:"path"`
-	Method       string            `yaml:"method" json:"method"`
-	Header       map[string]string `yaml:"header" json:"header"`
-	Body         string            `yaml:"body" json:"body"`
-	BodyFromFile string            `yaml:"bodyFromFile" json:"bodyFromFile"`
+	Protocol     string            `yaml:"protocol,omitempty" json:"protocol"`
+	Path         string            `yaml:"path,omitempty" json:"path"`
+	Method       string            `yaml:"method,omitempty" json:"method"`
+	Header       map[string]string `yaml:"header,omitempty" json:"header"`
+	Body         string            `yaml:"body,omitempty" json:"body"`
+	BodyFromFile string            `yaml:"bodyFromFile,omitempty" json:"bodyFromFile"`
+	// HeaderRegex matches the header values with regular expressions
+	HeaderRegex map[string]string  `yaml:"headerRegex,omitempty" json:"headerRegex"`
+	Query       map[string]Matcher `yaml:"query,omitempty" json:"query"`
+	// Form matches the fields of the URL-encoded form body
+	Form map[string]Matcher `yaml:"form,omitempty" json:"form"`
+	// BodyJSON matches the JSON body, the key is a gjson path, such as: user.name
+	BodyJSON map[string]Matcher `yaml:"bodyJSON,omitempty" json:"bodyJSON"`
+	// RPC is the proto of the gRPC item
+	RPC *RPC `yaml:"rpc,omitempty" json:"rpc"`
+}
+
+// RPC describes where to load the proto descriptors
+type RPC struct {
+	ProtoFile  string   `yaml:"protofile,omitempty" json:"protofile"`
+	ProtoSet   string   `yaml:"protoset,omitempty" json:"protoset"`
+	ImportPath []string `yaml:"import,omitempty" json:"import"`
 }
 
 type RequestWithAuth struct {
@@ -45,12 +110,23 @@ type RequestWithAuth struct {
 }
 
 type Response struct {
-	Encoder      string            `yaml:"encoder" json:"encoder"`
-	Body         string            `yaml:"body" json:"body"`
-	BodyFromFile string            `yaml:"bodyFromFile" json:"bodyFromFile"`
-	Header       map[string]string `yaml:"header" json:"header"`
-	StatusCode   int               `yaml:"statusCode" json:"statusCode"`
-	BodyData     []byte
+	Encoder      string            `yaml:"encoder,omitempty" json:"encoder"`
+	Body         string            `yaml:"body,omitempty" json:"body"`
+	BodyFromFile string            `yaml:"bodyFromFile,omitempty" json:"bodyFromFile"`
+	Header       map[string]string `yaml:"header,omitempty" json:"header"`
+	StatusCode   int               `yaml:"statusCode,omitempty" json:"statusCode"`
+	BodyData     []byte            `yaml:"bodydata,omitempty"`
+	// Trailer is the trailer metadata of the gRPC response
+	Trailer map[string]string `yaml:"trailer,omitempty" json:"trailer"`
+	// GRPCStatus is the status of the gRPC response, it is OK by default
+	GRPCStatus *GRPCStatus `yaml:"grpcStatus,omitempty" json:"grpcStatus"`
+}
+
+// GRPCStatus is the status of a gRPC response
+type GRPCStatus struct {
+	// Code is the name or the number of the status code, such as: NOT_FOUND, 5
+	Code    string `yaml:"code,omitempty" json:"code"`
+	Message string `yaml:"message,omitempty" json:"message"`
 }
 
 type Webhook struct {
@@ -58,6 +134,22 @@ type Webhook struct {
 	Timer   string            `yaml:"timer" json:"timer"`
 	Param   map[string]string `yaml:"param" json:"param"`
 	Request RequestWithAuth   `yaml:"request" json:"request"`
+	// Trigger sends the webhook when the item is hit, it works without the timer
+	Trigger *WebhookTrigger `yaml:"trigger,omitempty" json:"trigger,omitempty"`
+	Retry   *WebhookRetry   `yaml:"retry,omitempty" json:"retry,omitempty"`
+}
+
+type WebhookTrigger struct {
+	// Item is the name of the mock item
+	Item  string `yaml:"item" json:"item"`
+	Delay string `yaml:"delay,omitempty" json:"delay,omitempty"`
+}
+
+// WebhookRetry resends the failed webhook, the backoff doubles after each retry
+type WebhookRetry struct {
+	Times      int    `yaml:"times" json:"times"`
+	Backoff    string `yaml:"backoff,omitempty" json:"backoff,omitempty"`
+	MaxBackoff string `yaml:"maxBackoff,omitempty" json:"maxBackoff,omitempty"`
 }
 
 type Proxy struct {
@@ -68,6 +160,24 @@ type Proxy struct {
 	RequestAmend RequestAmend `yaml:"requestAmend" json:"requestAmend"`
 	Protocol     string       `yaml:"protocol" json:"protocol"`
 	Echo         bool         `yaml:"echo" json:"echo"`
+	// Fault overrides the global fault of the server
+	Fault *Fault `yaml:"fault" json:"fault"`
+	// Mode could be empty (forward only), record, or replay
+	Mode   string       `yaml:"mode" json:"mode"`
+	Record *ProxyRecord `yaml:"record" json:"record"`
+}
+
+// ProxyRecord describes where and how to record the proxy traffic
+type ProxyRecord struct {
+	// File is the mock config file which the recorded items are written into
+	File string `yaml:"file" json:"file"`
+	// SuiteFile is an optional test suite file which the recorded test cases are written into
+	SuiteFile string `yaml:"suiteFile" json:"suiteFile"`
+	// AllowHeaders records the given headers only, all headers are allowed if it is empty
+	AllowHeaders []string `yaml:"allowHeaders" json:"allowHeaders"`
+	DenyHeaders  []string `yaml:"denyHeaders" json:"denyHeaders"`
+	// Dedupe records the first response only of the same request
+	Dedupe bool `yaml:"dedupe" json:"dedupe"`
 }
 
 type RequestAmend struct {
@@ -75,8 +185,52 @@ type RequestAmend struct {
 }
 
 type Server struct {
-	Objects  []Object  `yaml:"objects" json:"objects"`
-	Items    []Item    `yaml:"items" json:"items"`
-	Proxies  []Proxy   `yaml:"proxies" json:"proxies"`
-	Webhooks []Webhook `yaml:"webhooks" json:"webhooks"`
+	Objects   []Object   `yaml:"objects" json:"objects"`
+	Items     []Item     `yaml:"items" json:"items"`
+	Proxies   []Proxy    `yaml:"proxies" json:"proxies"`
+	Webhooks  []Webhook  `yaml:"webhooks" json:"webhooks"`
+	Scenarios []Scenario `yaml:"scenarios" json:"scenarios"`
+	// Fault applies to all the items and proxies
+	Fault *Fault `yaml:"fault" json:"fault"`
+	// OpenAPI serves all the operations of the document, the items take precedence over them
+	OpenAPI *OpenAPI `yaml:"openAPI" json:"openAPI"`
+	// OIDC serves a fake OAuth2/OIDC identity provider
+	OIDC *OIDC `yaml:"oidc" json:"oidc"`
+}
+
+// OIDC is a minimal OAuth2/OIDC identity provider
+type OIDC struct {
+	// Path is the prefix of the endpoints, it is /oidc by default
+	Path string `yaml:"path" json:"path"`
+	// Issuer is generated from the request host if it is empty
+	Issuer string `yaml:"issuer" json:"issuer"`
+	// TokenTTL is the lifetime of the access and ID tokens, it is 1h by default
+	TokenTTL string `yaml:"tokenTTL" json:"tokenTTL"`
+	// Clients accepts any client without a secret if it is empty
+	Clients []OIDCClient `yaml:"clients" json:"clients"`
+	Users   []OIDCUser   `yaml:"users" json:"users"`
+}
+
+type OIDCClient struct {
+	ID string `yaml:"id" json:"id"`
+	// Secret is empty for a public client, PKCE is required in this case
+	Secret string `yaml:"secret" json:"secret"`
+	// RedirectURIs accepts any redirect URI if it is empty
+	RedirectURIs []string `yaml:"redirectURIs" json:"redirectURIs"`
+}
+
+type OIDCUser struct {
+	Username string `yaml:"username" json:"username"`
+	Password string `yaml:"password" json:"password"`
+	// Claims are added into the ID token and the userinfo, such as: email, groups
+	Claims map[string]interface{} `yaml:"claims" json:"claims"`
+}
+
+// OpenAPI is an OpenAPI 3 or Swagger 2 document
+type OpenAPI struct {
+	File string `yaml:"file" json:"file"`
+	// SkipValidation skips validating the requests against the parameter and body schemas
+	SkipValidation bool `yaml:"skipValidation" json:"skipValidation"`
+	// data is the content of the document which is given directly
+	data []byte
 }
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWebhookBackoff    = time.Second
+	defaultWebhookMaxBackoff = 30 * time.Second
+)
+
+// webhookTriggers sends the webhooks when the mock items are hit
+type webhookTriggers struct {
+	ctx     context.Context
+	wg      *sync.WaitGroup
+	journal *requestJournal
+
+	mu    sync.RWMutex
+	hooks map[string][]Webhook
+}
+
+func newWebhookTriggers(ctx context.Context, wg *sync.WaitGroup, journal *requestJournal) *webhookTriggers {
+	return &webhookTriggers{
+		ctx:     ctx,
+		wg:      wg,
+		journal: journal,
+		hooks:   map[string][]Webhook{},
+	}
+}
+
+// load validates the webhooks and registers the ones which have a trigger
+func (t *webhookTriggers) load(webhooks []Webhook, items []Item) (err error) {
+	names := make(map[string]bool, len(items))
+	for _, item := range items {
+		names[item.Name] = true
+	}
+
+	hooks := map[string][]Webhook{}
+	for _, webhook := range webhooks {
+		if err = validateWebhook(webhook); err != nil {
+			err = fmt.Errorf("invalid webhook %q: %v", webhook.Name, err)
+			return
+		}
+		if webhook.Trigger == nil {
+			continue
+		}
+		if !names[webhook.Trigger.Item] {
+			err = fmt.Errorf("item %q of the webhook %q trigger is not found", webhook.Trigger.Item, webhook.Name)
+			return
+		}
+		hooks[webhook.Trigger.Item] = append(hooks[webhook.Trigger.Item], webhook)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.hooks = hooks
+	return
+}
+
+func validateWebhook(webhook Webhook) (err error) {
+	if webhook.Timer == "" && webhook.Trigger == nil {
+		err = fmt.Errorf("either timer or trigger is required")
+		return
+	}
+	if webhook.Trigger != nil {
+		if _, err = parseOptionalDuration(webhook.Trigger.Delay); err != nil {
+			err = fmt.Errorf("invalid delay: %v", err)
+			return
+		}
+	}
+	if webhook.Retry != nil {
+		if webhook.Retry.Times < 0 {
+			err = fmt.Errorf("retry times should not be negative")
+			return
+		}
+		if _, err = parseOptionalDuration(webhook.Retry.Backoff); err != nil {
+			err = fmt.Errorf("invalid backoff: %v", err)
+			return
+		}
+		if _, err = parseOptionalDuration(webhook.Retry.MaxBackoff); err != nil {
+			err = fmt.Errorf("invalid max backoff: %v", err)
+		}
+	}
+	return
+}
+
+func parseOptionalDuration(text string) (duration time.Duration, err error) {
+	if text != "" {
+		duration, err = time.ParseDuration(text)
+	}
+	return
+}
+
+// trigger sends the webhooks of the item in background,
+// the params of the request are available in the templates of the webhook
+func (t *webhookTriggers) trigger(item string, params map[string]interface{}) {
+	t.mu.RLock()
+	hooks := t.hooks[item]
+	t.mu.RUnlock()
+
+	for _, webhook := range hooks {
+		webhookParams := webhook.Param
+		webhook.Param = make(map[string]string, len(params)+len(webhookParams))
+		for k, v := range params {
+			webhook.Param[k] = fmt.Sprint(v)
+		}
+		// the params of the webhook have higher priority
+		for k, v := range webhookParams {
+			webhook.Param[k] = v
+		}
+		memLogger.Info("trigger webhook", "name", webhook.Name, "item", item)
+
+		t.wg.Add(1)
+		go func(wh Webhook) {
+			defer t.wg.Done()
+
+			delay, _ := parseOptionalDuration(wh.Trigger.Delay)
+			if !t.wait(delay) {
+				return
+			}
+			t.deliver(wh, wh)
+		}(webhook)
+	}
+}
+
+// deliver sends the webhook and retries with the backoff if it fails,
+// each attempt is recorded into the journal
+func (t *webhookTriggers) deliver(wh Webhook, objCtx interface{}) {
+	var times int
+	backoff, maxBackoff := defaultWebhookBackoff, defaultWebhookMaxBackoff
+	if wh.Retry != nil {
+		times = wh.Retry.Times
+		if duration, _ := parseOptionalDuration(wh.Retry.Backoff); duration > 0 {
+			backoff = duration
+		}
+		if duration, _ := parseOptionalDuration(wh.Retry.MaxBackoff); duration > 0 {
+			maxBackoff = duration
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		// the params are rendered in place, avoid sharing them between the deliveries
+		webhook := wh
+		webhook.Param = make(map[string]string, len(wh.Param))
+		for k, v := range wh.Param {
+			webhook.Param[k] = v
+		}
+
+		entry, err := runWebhook(t.ctx, objCtx, &webhook)
+		entry.Kind = JournalKindWebhook
+		entry.Time = time.Now()
+		entry.Item = wh.Name
+		entry.Attempt = attempt
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		t.journal.record(entry)
+
+		if err == nil {
+			memLogger.Info("webhook delivered", "name", wh.Name, "api", entry.Path, "code", entry.Status, "attempt", attempt)
+			return
+		}
+		if attempt > times {
+			memLogger.Error(err, "failed to deliver webhook", "name", wh.Name, "api", entry.Path, "attempt", attempt)
+			return
+		}
+
+		memLogger.Info("retry webhook", "name", wh.Name, "error", err.Error(), "attempt", attempt, "backoff", backoff.String())
+		if !t.wait(backoff) {
+			return
+		}
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// wait returns false if the mock server is stopped
+func (t *webhookTriggers) wait(duration time.Duration) bool {
+	if duration <= 0 {
+		return t.ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-t.ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mock
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTriggerWebhook(t *testing.T) {
+	var mu sync.Mutex
+	var received []string
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		data, _ := io.ReadAll(req.Body)
+		received = append(received, req.URL.Path+" "+string(data))
+		if len(received) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer target.Close()
+
+	server := NewInMemoryServer(context.Background(), 0)
+	handler, err := server.SetupHandler(NewInMemoryReader(fmt.Sprintf(`items:
+  - name: createOrder
+    request:
+      path: /orders/{id}
+      method: POST
+    response:
+      statusCode: 201
+webhooks:
+  - name: orderCreated
+    trigger:
+      item: createOrder
+      delay: 10ms
+    retry:
+      times: 2
+      backoff: 10ms
+    request:
+      path: %s/callback/{{.Param.id}}
+      body: '{"id": "{{.Param.id}}", "order": {{.Param._payload}}}'`, target.URL)), "/mock")
+	assert.NoError(t, err)
+	defer server.Stop()
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/mock/orders/123", bytes.NewBufferString(`{"count":1}`)))
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	reader := server.(JournalReader)
+	filter := JournalFilter{Kind: JournalKindWebhook, Item: "orderCreated"}
+	assert.Eventually(t, func() bool {
+		entries, _ := reader.GetJournal(filter)
+		return len(entries) == 2
+	}, 3*time.Second, 10*time.Millisecond)
+
+	entries, err := reader.GetJournal(filter)
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, http.StatusInternalServerError, entries[0].Status)
+		assert.Equal(t, 1, entries[0].Attempt)
+		assert.Contains(t, entries[0].Error, "unexpected status code 500")
+		assert.Equal(t, http.StatusOK, entries[1].Status)
+		assert.Equal(t, 2, entries[1].Attempt)
+		assert.Empty(t, entries[1].Error)
+		assert.Equal(t, http.MethodPost, entries[1].Method)
+		assert.Equal(t, target.URL+"/callback/123", entries[1].Path)
+	}
+
+	mu.Lock()
+	assert.Equal(t, []string{
+		`/callback/123 {"id": "123", "order": {"count":1}}`,
+		`/callback/123 {"id": "123", "order": {"count":1}}`,
+	}, received)
+	mu.Unlock()
+
+	// the webhook deliveries are not mixed with the received requests
+	entries, err = reader.GetJournal(JournalFilter{})
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, "createOrder", entries[0].Item)
+	}
+}
+
+func TestWebhookGiveUp(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer target.Close()
+
+	journal := newRequestJournal(defaultJournalSize)
+	triggers := newWebhookTriggers(context.Background(), &sync.WaitGroup{}, journal)
+	triggers.deliver(Webhook{
+		Name:  "notify",
+		Retry: &WebhookRetry{Times: 1, Backoff: "1ms"},
+		Request: RequestWithAuth{Request: Request{
+			Path: target.URL,
+		}},
+	}, nil)
+
+	entries, err := journal.list(JournalFilter{Kind: JournalKindWebhook})
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, http.StatusBadGateway, entries[1].Status)
+		assert.Equal(t, 2, entries[1].Attempt)
+	}
+}
+
+func TestLoadWebhookTriggers(t *testing.T) {
+	triggers := newWebhookTriggers(context.Background(), &sync.WaitGroup{}, newRequestJournal(1))
+	items := []Item{{Name: "users"}}
+
+	err := triggers.load([]Webhook{{Name: "a", Trigger: &WebhookTrigger{Item: "orders"}}}, items)
+	assert.ErrorContains(t, err, `item "orders" of the webhook "a" trigger is not found`)
+
+	err = triggers.load([]Webhook{{Name: "a", Trigger: &WebhookTrigger{Item: "users", Delay: "1x"}}}, items)
+	assert.ErrorContains(t, err, "invalid delay")
+
+	err = triggers.load([]Webhook{{Name: "a", Timer: "1s", Retry: &WebhookRetry{Backoff: "x"}}}, items)
+	assert.ErrorContains(t, err, "invalid backoff")
+
+	err = triggers.load([]Webhook{{Name: "a"}}, items)
+	assert.ErrorContains(t, err, "either timer or trigger is required")
+
+	err = triggers.load([]Webhook{{Name: "a", Timer: "1s"}, {Name: "b", Trigger: &WebhookTrigger{Item: "users"}}}, items)
+	assert.NoError(t, err)
+	assert.Len(t, triggers.hooks["users"], 1)
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/linuxsuren/api-testing/pkg/secret"
+)
+
+// SecretMask is the replacement of the secret values
+const SecretMask = "******"
+
+// the short values are ignored, otherwise, the normal output might be broken
+const minSecretLength = 3
+
+var secretValues = &secretValueSet{
+	values: map[string]struct{}{},
+}
+
+type secretValueSet struct {
+	values map[string]struct{}
+	sorted []string
+	mu     sync.RWMutex
+}
+
+func (s *secretValueSet) add(value string) {
+	if len(value) < minSecretLength {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.values[value]; ok {
+		return
+	}
+	s.values[value] = struct{}{}
+
+	// replace the longer values first, in case one value contains another one
+	s.sorted = append(s.sorted, value)
+	sort.Slice(s.sorted, func(i, j int) bool {
+		return len(s.sorted[i]) > len(s.sorted[j])
+	})
+}
+
+// safeCut returns the position before which the text could be masked and written. The rest might be
+// the beginning of a secret value, or a part of a secret value, which needs the following data
+func (s *secretValueSet) safeCut(text string) (cut int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cut = len(text)
+	for _, value := range s.sorted {
+		for i := max(0, len(text)-len(value)+1); i < cut; i++ {
+			if strings.HasPrefix(value, text[i:]) {
+				cut = i
+				break
+			}
+		}
+	}
+
+	// do not split a complete secret value
+	for moved := true; moved; {
+		moved = false
+		for _, value := range s.sorted {
+			from := max(0, cut-len(value)+1)
+			if index := strings.Index(text[from:], value); index >= 0 && from+index < cut {
+				cut = from + index
+				moved = true
+			}
+		}
+	}
+	return
+}
+
+func (s *secretValueSet) mask(text string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, value := range s.sorted {
+		text = strings.ReplaceAll(text, value, SecretMask)
+	}
+	return text
+}
+
+// AddSecretValue records a secret value which needs to be masked
+func AddSecretValue(value string) {
+	secretValues.add(value)
+}
+
+// MaskSecrets replaces all the known secret values with the mask
+func MaskSecrets(text string) string {
+	return secretValues.mask(text)
+}
+
+// MaskSecretsInMap replaces all the known secret values of the map with the mask
+func MaskSecretsInMap(data map[string]string) map[string]string {
+	if data == nil {
+		return nil
+	}
+	result := make(map[string]string, len(data))
+	for key, val := range data {
+		result[key] = MaskSecrets(val)
+	}
+	return result
+}
+
+type maskWriter struct {
+	writer io.Writer
+	// pending is the tail which might be the beginning of a secret value
+	pending []byte
+	mu      sync.Mutex
+}
+
+// NewMaskWriter creates a writer which masks the secret values
+func NewMaskWriter(writer io.Writer) io.Writer {
+	if writer == nil {
+		return nil
+	}
+	if _, ok := writer.(*maskWriter); ok {
+		return writer
+	}
+	return &maskWriter{writer: writer}
+}
+
+// Write masks the secret values then writes to the target writer. A secret value might be split
+// across the writings, so the tail which could be the beginning of it is kept until the next writing
+func (w *maskWriter) Write(p []byte) (n int, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	text := string(append(w.pending, p...))
+	cut := secretValues.safeCut(text)
+	if cut > 0 {
+		if _, err = io.WriteString(w.writer, MaskSecrets(text[:cut])); err != nil {
+			return
+		}
+	}
+	w.pending = []byte(text[cut:])
+	n = len(p)
+	return
+}
+
+// Flush writes the kept tail
+func (w *maskWriter) Flush() (err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.pending) > 0 {
+		_, err = io.WriteString(w.writer, MaskSecrets(string(w.pending)))
+		w.pending = nil
+	}
+	return
+}
+
+// FlushMaskWriter writes the data which is kept by the mask writer, it does nothing for the other writers
+func FlushMaskWriter(writer io.Writer) (err error) {
+	if w, ok := writer.(*maskWriter); ok {
+		err = w.Flush()
+	}
+	return
+}
+
+type maskSecretGetter struct {
+	getter secret.SecretGetter
+}
+
+// NewMaskSecretGetter creates a secret getter which records the secret values for masking
+func NewMaskSecretGetter(getter secret.SecretGetter) secret.SecretGetter {
+	if _, ok := getter.(*maskSecretGetter); ok {
+		return getter
+	}
+	return &maskSecretGetter{getter: getter}
+}
+
+func (g *maskSecretGetter) GetSecret(name string) (s secret.Secret, err error) {
+	if s, err = g.getter.GetSecret(name); err == nil {
+		AddSecretValue(s.Value)
+	}
+	return
+}
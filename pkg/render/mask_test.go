@@ -0,0 +1,116 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaskSecrets(t *testing.T) {
+	SetSecretGetter(&nonSecretGetter{
+		value: "mask-secret",
+	})
+	result, err := Render("", `token={{secretValue "token"}}`, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "token=mask-secret", result)
+	assert.Equal(t, "token=******", MaskSecrets(result))
+
+	t.Run("longer value first", func(t *testing.T) {
+		AddSecretValue("mask-secret-longer")
+		assert.Equal(t, "******,******", MaskSecrets("mask-secret-longer,mask-secret"))
+	})
+
+	t.Run("ignore short value", func(t *testing.T) {
+		AddSecretValue("ab")
+		AddSecretValue("")
+		assert.Equal(t, "abc", MaskSecrets("abc"))
+	})
+
+	t.Run("map", func(t *testing.T) {
+		assert.Nil(t, MaskSecretsInMap(nil))
+		assert.Equal(t, map[string]string{
+			"Authorization": "Bearer ******",
+		}, MaskSecretsInMap(map[string]string{
+			"Authorization": "Bearer mask-secret",
+		}))
+	})
+
+	t.Run("writer", func(t *testing.T) {
+		assert.Nil(t, NewMaskWriter(nil))
+
+		buf := new(bytes.Buffer)
+		writer := NewMaskWriter(buf)
+		assert.Equal(t, writer, NewMaskWriter(writer))
+
+		n, err := fmt.Fprint(writer, "token: mask-secret")
+		assert.NoError(t, err)
+		assert.Equal(t, 18, n)
+		// mask-secret is kept, it might be the beginning of mask-secret-longer
+		assert.Equal(t, "token: ", buf.String())
+		assert.NoError(t, FlushMaskWriter(writer))
+		assert.Equal(t, "token: ******", buf.String())
+	})
+
+	t.Run("secret is split across writings", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		writer := NewMaskWriter(buf)
+
+		_, err := fmt.Fprint(writer, "token: mask-")
+		assert.NoError(t, err)
+		assert.Equal(t, "token: ", buf.String())
+		_, err = fmt.Fprint(writer, "sec")
+		assert.NoError(t, err)
+		_, err = fmt.Fprint(writer, "ret\n")
+		assert.NoError(t, err)
+		assert.Equal(t, "token: ******\n", buf.String())
+
+		_, err = fmt.Fprint(writer, "name: mask")
+		assert.NoError(t, err)
+		assert.Equal(t, "token: ******\nname: ", buf.String())
+		assert.NoError(t, FlushMaskWriter(writer))
+		assert.Equal(t, "token: ******\nname: mask", buf.String())
+		assert.NoError(t, FlushMaskWriter(buf))
+	})
+
+	t.Run("do not split a complete secret", func(t *testing.T) {
+		AddSecretValue("xyz12")
+		AddSecretValue("12345")
+
+		buf := new(bytes.Buffer)
+		writer := NewMaskWriter(buf)
+		_, err := fmt.Fprint(writer, "a xyz12")
+		assert.NoError(t, err)
+		assert.Equal(t, "a ", buf.String())
+		_, err = fmt.Fprint(writer, "\n")
+		assert.NoError(t, err)
+		assert.Equal(t, "a ******\n", buf.String())
+	})
+
+	t.Run("secret getter", func(t *testing.T) {
+		getter := NewMaskSecretGetter(&nonSecretGetter{value: "getter-secret"})
+		assert.Equal(t, getter, NewMaskSecretGetter(getter))
+
+		s, err := getter.GetSecret("name")
+		assert.NoError(t, err)
+		assert.Equal(t, "getter-secret", s.Value)
+		assert.Equal(t, "******", MaskSecrets("getter-secret"))
+	})
+}
@@ -30,6 +30,7 @@ import (
 	"math"
 	mathrand "math/rand"
 	"net/url"
+	"os"
 	"strconv"
 	"strings"
 	"text/template"
@@ -50,14 +51,14 @@ import (
 
 var secretGetter secret.SecretGetter
 
-// SetSecretGetter set the secret getter
+// SetSecretGetter set the secret getter, all the values from it will be masked in the output
 func SetSecretGetter(getter secret.SecretGetter) {
 	if getter == nil {
 		getter = &nonSecretGetter{
 			err: fmt.Errorf("no secret server"),
 		}
 	}
-	secretGetter = getter
+	secretGetter = NewMaskSecretGetter(getter)
 }
 
 // Render render then return the result
@@ -73,6 +74,7 @@ func RenderAsBytes(name, text string, ctx interface{}) (data []byte, err error)
 	var tpl *template.Template
 	if tpl, err = template.New(name).
 		Funcs(FuncMap()).
+		Funcs(contextFuncMap(ctx)).
 		Parse(text); err == nil {
 		buf := new(bytes.Buffer)
 		if err = tpl.Execute(buf, ctx); err == nil {
@@ -107,6 +109,23 @@ func FuncMap() template.FuncMap {
 	return funcs
 }
 
+// contextFuncMap returns the functions which depend on the context. The env function reads the
+// environment variables of the current run, such as {{.env.SERVER}}, before the ones of the process
+func contextFuncMap(ctx interface{}) template.FuncMap {
+	funcs := template.FuncMap{}
+	if data, ok := ctx.(map[string]interface{}); ok {
+		if env, ok := data["env"].(map[string]string); ok {
+			funcs["env"] = func(key string) string {
+				if val, ok := env[key]; ok {
+					return val
+				}
+				return os.Getenv(key)
+			}
+		}
+	}
+	return funcs
+}
+
 // FuncUsage returns the usage of target template function
 func FuncUsage(funcName string) (usage string) {
 	usageMap := make(map[string]string)
@@ -239,6 +239,12 @@ func TestRenderThenPrint(t *testing.T) {
 		ctx:     map[string]string{"name": "linuxsuren"},
 		buf:     new(bytes.Buffer),
 		expect:  "linuxsuren",
+	}, {
+		name:    "env of the context",
+		tplText: `{{env "ATEST_NAME"}}`,
+		ctx:     map[string]interface{}{"env": map[string]string{"ATEST_NAME": "linuxsuren"}},
+		buf:     new(bytes.Buffer),
+		expect:  "linuxsuren",
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
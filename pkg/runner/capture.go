@@ -0,0 +1,102 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/tidwall/gjson"
+)
+
+// captureVariables extracts the variables from the response into the run-scoped variables
+func captureVariables(testcase *testing.TestCase, resp *http.Response, body []byte, dataContext interface{}) (err error) {
+	if len(testcase.Capture) == 0 {
+		return
+	}
+
+	vars := testing.GetVariables(dataContext)
+	for _, capture := range testcase.Capture {
+		var value interface{}
+		if value, err = captureValue(capture, resp, body); err != nil {
+			err = fmt.Errorf("failed to capture variable %q in test case %q: %v", capture.Name, testcase.Name, err)
+			return
+		}
+		vars.Set(capture.Name, value)
+	}
+	return
+}
+
+func captureValue(capture testing.Capture, resp *http.Response, body []byte) (value interface{}, err error) {
+	if capture.Name == "" {
+		err = errors.New("the name is required")
+		return
+	}
+
+	switch capture.Type {
+	case testing.CaptureTypeJSON:
+		if !gjson.ValidBytes(body) {
+			err = errors.New("the response body is not a valid JSON")
+			return
+		}
+
+		result := gjson.GetBytes(body, capture.Expression)
+		if !result.Exists() {
+			err = fmt.Errorf("json path %q does not exist in the response body", capture.Expression)
+		} else {
+			value = result.Value()
+		}
+	case testing.CaptureTypeRegex:
+		var reg *regexp.Regexp
+		if reg, err = regexp.Compile(capture.Expression); err != nil {
+			err = fmt.Errorf("invalid regular expression %q: %v", capture.Expression, err)
+			return
+		}
+
+		// take the first group if there is, or the whole matched text
+		if matched := reg.FindSubmatch(body); len(matched) == 0 {
+			err = fmt.Errorf("regular expression %q does not match the response body", capture.Expression)
+		} else if len(matched) > 1 {
+			value = string(matched[1])
+		} else {
+			value = string(matched[0])
+		}
+	case testing.CaptureTypeHeader:
+		if values := resp.Header.Values(capture.Expression); len(values) == 0 {
+			err = fmt.Errorf("header %q does not exist in the response", capture.Expression)
+		} else {
+			value = values[0]
+		}
+	case testing.CaptureTypeCookie:
+		err = fmt.Errorf("cookie %q does not exist in the response", capture.Expression)
+		for _, cookie := range resp.Cookies() {
+			if cookie.Name == capture.Expression {
+				value = cookie.Value
+				err = nil
+				break
+			}
+		}
+	case testing.CaptureTypeStatus:
+		value = resp.StatusCode
+	default:
+		err = fmt.Errorf("unsupported capture type %q", capture.Type)
+	}
+	return
+}
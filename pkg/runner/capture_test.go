@@ -0,0 +1,175 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureValue(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusCreated,
+		Header: http.Header{
+			"X-Request-Id": []string{"request-id"},
+			"Set-Cookie":   []string{"session=session-id; Path=/"},
+		},
+	}
+	body := []byte(`{"data":{"token":"token","count":3,"items":[{"id":1}]}}`)
+
+	tests := []struct {
+		name     string
+		capture  atest.Capture
+		body     []byte
+		expect   interface{}
+		errorMsg string
+	}{{
+		name:    "json string",
+		capture: atest.Capture{Name: "token", Type: atest.CaptureTypeJSON, Expression: "data.token"},
+		expect:  "token",
+	}, {
+		name:    "json number",
+		capture: atest.Capture{Name: "count", Type: atest.CaptureTypeJSON, Expression: "data.count"},
+		expect:  float64(3),
+	}, {
+		name:    "json object",
+		capture: atest.Capture{Name: "item", Type: atest.CaptureTypeJSON, Expression: "data.items.0"},
+		expect:  map[string]interface{}{"id": float64(1)},
+	}, {
+		name:     "json path not exist",
+		capture:  atest.Capture{Name: "fake", Type: atest.CaptureTypeJSON, Expression: "data.fake"},
+		errorMsg: `json path "data.fake" does not exist`,
+	}, {
+		name:     "invalid json",
+		capture:  atest.Capture{Name: "fake", Type: atest.CaptureTypeJSON, Expression: "data"},
+		body:     []byte(`invalid`),
+		errorMsg: "not a valid JSON",
+	}, {
+		name:    "regex with group",
+		capture: atest.Capture{Name: "token", Type: atest.CaptureTypeRegex, Expression: `"token":"(\w+)"`},
+		expect:  "token",
+	}, {
+		name:    "regex without group",
+		capture: atest.Capture{Name: "count", Type: atest.CaptureTypeRegex, Expression: `"count":\d+`},
+		expect:  `"count":3`,
+	}, {
+		name:     "regex not match",
+		capture:  atest.Capture{Name: "fake", Type: atest.CaptureTypeRegex, Expression: `fake`},
+		errorMsg: "does not match",
+	}, {
+		name:     "invalid regex",
+		capture:  atest.Capture{Name: "fake", Type: atest.CaptureTypeRegex, Expression: `(`},
+		errorMsg: "invalid regular expression",
+	}, {
+		name:    "header",
+		capture: atest.Capture{Name: "requestID", Type: atest.CaptureTypeHeader, Expression: "x-request-id"},
+		expect:  "request-id",
+	}, {
+		name:     "header not exist",
+		capture:  atest.Capture{Name: "fake", Type: atest.CaptureTypeHeader, Expression: "fake"},
+		errorMsg: `header "fake" does not exist`,
+	}, {
+		name:    "cookie",
+		capture: atest.Capture{Name: "session", Type: atest.CaptureTypeCookie, Expression: "session"},
+		expect:  "session-id",
+	}, {
+		name:     "cookie not exist",
+		capture:  atest.Capture{Name: "fake", Type: atest.CaptureTypeCookie, Expression: "fake"},
+		errorMsg: `cookie "fake" does not exist`,
+	}, {
+		name:    "status",
+		capture: atest.Capture{Name: "status", Type: atest.CaptureTypeStatus},
+		expect:  http.StatusCreated,
+	}, {
+		name:     "unsupported type",
+		capture:  atest.Capture{Name: "fake", Type: "fake"},
+		errorMsg: `unsupported capture type "fake"`,
+	}, {
+		name:     "no name",
+		capture:  atest.Capture{Type: atest.CaptureTypeStatus},
+		errorMsg: "name is required",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := body
+			if tt.body != nil {
+				data = tt.body
+			}
+
+			value, err := captureValue(tt.capture, resp, data)
+			if tt.errorMsg != "" {
+				assert.ErrorContains(t, err, tt.errorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expect, value)
+			}
+		})
+	}
+}
+
+func TestRunTestCaseWithCapture(t *testing.T) {
+	defer gock.Off()
+	gock.New(urlFoo).Post("/login").Reply(http.StatusOK).JSON(`{"token":"token"}`)
+	gock.New(urlFoo).Get("/users").MatchHeader("Authorization", "Bearer token").
+		Reply(http.StatusOK).JSON(`[]`)
+
+	dataContext := map[string]interface{}{}
+	runner := NewSimpleTestCaseRunner()
+	_, err := runner.RunTestCase(&atest.TestCase{
+		Name: "login",
+		Request: atest.Request{
+			API:    urlFoo + "/login",
+			Method: http.MethodPost,
+		},
+		Capture: []atest.Capture{{
+			Name: "token", Type: atest.CaptureTypeJSON, Expression: "token",
+		}},
+	}, dataContext, context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, atest.Variables{"token": "token"}, atest.GetVariables(dataContext))
+
+	_, err = runner.RunTestCase(&atest.TestCase{
+		Name: "users",
+		Request: atest.Request{
+			API: urlFoo + "/users",
+			Header: map[string]string{
+				"Authorization": "Bearer {{.vars.token}}",
+			},
+		},
+	}, dataContext, context.Background())
+	assert.NoError(t, err)
+	assert.True(t, gock.IsDone())
+
+	t.Run("failed to capture", func(t *testing.T) {
+		gock.New(urlFoo).Get("/users").Reply(http.StatusOK).JSON(`[]`)
+		_, err := runner.RunTestCase(&atest.TestCase{
+			Name: "users",
+			Request: atest.Request{
+				API: urlFoo + "/users",
+			},
+			Capture: []atest.Capture{{
+				Name: "id", Type: atest.CaptureTypeJSON, Expression: "0.id",
+			}},
+		}, dataContext, context.Background())
+		assert.ErrorContains(t, err, `failed to capture variable "id" in test case "users"`)
+	})
+}
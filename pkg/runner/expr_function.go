@@ -22,9 +22,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/expr-lang/expr"
@@ -32,10 +34,12 @@ import (
 	"github.com/expr-lang/expr/builtin"
 	"github.com/expr-lang/expr/vm"
 	"github.com/linuxsuren/api-testing/pkg/logging"
+	"github.com/linuxsuren/api-testing/pkg/render"
 )
 
 var (
-	runnerLogger = logging.DefaultLogger(logging.LogLevelInfo).WithName("runner")
+	// the secret values are masked in the logs of the runners
+	runnerLogger = logging.DefaultLogger(logging.LogLevelInfo).WithNameAndWriter("runner", render.NewMaskWriter(os.Stdout))
 )
 
 // ExprFuncSleep is an expr function for sleeping
@@ -128,6 +132,65 @@ func ExprFuncHTTPReady(params ...interface{}) (res interface{}, err error) {
 	return
 }
 
+// mockCallsClient avoids waiting for an unresponsive mock server forever
+var mockCallsClient = &http.Client{Timeout: 10 * time.Second}
+
+// ExprFuncMockCalls is an expr function for counting the requests received by a mock server.
+// Usage: mockCalls(server, item, [bodyRegex]), the server is the address with the prefix, such as: http://localhost:6060/mock
+func ExprFuncMockCalls(params ...interface{}) (res interface{}, err error) {
+	if len(params) < 2 {
+		err = fmt.Errorf("usage: mockCalls server item [bodyRegex]")
+		return
+	}
+
+	var server, item, body string
+	var ok bool
+	if server, ok = params[0].(string); !ok {
+		err = fmt.Errorf("the server param should be a string")
+		return
+	}
+	if item, ok = params[1].(string); !ok {
+		err = fmt.Errorf("the item param should be a string")
+		return
+	}
+	if len(params) >= 3 {
+		if body, ok = params[2].(string); !ok {
+			err = fmt.Errorf("the bodyRegex param should be a string")
+			return
+		}
+	}
+
+	query := url.Values{}
+	query.Set("item", item)
+	if body != "" {
+		query.Set("body", body)
+	}
+	api := fmt.Sprintf("%s/_journal/verify?%s", strings.TrimSuffix(server, "/"), query.Encode())
+
+	var resp *http.Response
+	if resp, err = mockCallsClient.Get(api); err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	var data []byte
+	if data, err = io.ReadAll(resp.Body); err != nil {
+		return
+	}
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("failed to verify the mock journal, status code: %d, response: %s", resp.StatusCode, string(data))
+		return
+	}
+
+	result := struct {
+		Count int `json:"count"`
+	}{}
+	if err = json.Unmarshal(data, &result); err == nil {
+		res = result.Count
+	}
+	return
+}
+
 func init() {
 	builtin.Builtins = append(builtin.Builtins, []*ast.Function{
 		{
@@ -138,6 +201,14 @@ func init() {
 			Name: "httpReady",
 			Func: ExprFuncHTTPReady,
 		},
+		{
+			Name: "mockCalls",
+			Func: ExprFuncMockCalls,
+		},
+		{
+			Name: "sql",
+			Func: ExprFuncSQL,
+		},
 		{
 			Name: "command",
 			Func: func(params ...interface{}) (res any, err error) {
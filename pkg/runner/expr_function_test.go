@@ -17,9 +17,11 @@ limitations under the License.
 package runner_test
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
@@ -28,7 +30,9 @@ import (
 	"github.com/expr-lang/expr"
 	"github.com/expr-lang/expr/vm"
 	"github.com/h2non/gock"
+	"github.com/linuxsuren/api-testing/pkg/mock"
 	"github.com/linuxsuren/api-testing/pkg/runner"
+	"github.com/linuxsuren/api-testing/pkg/util"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -125,6 +129,58 @@ func TestExprFuncHTTPReady(t *testing.T) {
 	})
 }
 
+func TestExprFuncMockCalls(t *testing.T) {
+	mockServer := mock.NewInMemoryServer(context.Background(), 0)
+	handler, err := mockServer.SetupHandler(mock.NewInMemoryReader(`items:
+  - name: users
+    request:
+      path: /users
+      method: POST
+    response:
+      body: ok`), "/mock")
+	assert.NoError(t, err)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	api := server.URL + "/mock"
+	for _, body := range []string{`{"name":"rick"}`, `{"name":"rick"}`, `{"name":"morty"}`} {
+		_, err = http.Post(api+"/users", util.JSON, strings.NewReader(body))
+		assert.NoError(t, err)
+	}
+
+	count, err := runner.ExprFuncMockCalls(api, "users")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	count, err = runner.ExprFuncMockCalls(api, "users", "rick")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	var program *vm.Program
+	program, err = expr.Compile(fmt.Sprintf(`mockCalls("%s", "users", "morty") == 1`, api))
+	if assert.NoError(t, err) {
+		var result interface{}
+		result, err = expr.Run(program, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, true, result)
+	}
+
+	_, err = runner.ExprFuncMockCalls(api, "users", "(")
+	assert.Error(t, err)
+
+	_, err = runner.ExprFuncMockCalls(api)
+	assert.Error(t, err)
+
+	_, err = runner.ExprFuncMockCalls(1, "users")
+	assert.Error(t, err)
+
+	_, err = runner.ExprFuncMockCalls(api, 1)
+	assert.Error(t, err)
+
+	_, err = runner.ExprFuncMockCalls(api, "users", 1)
+	assert.Error(t, err)
+}
+
 func TestFunctions(t *testing.T) {
 	tmpFile, err := os.CreateTemp(os.TempDir(), "test")
 	if err != nil {
@@ -18,19 +18,31 @@ package runner
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/linuxsuren/api-testing/pkg/render"
 	"github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
 )
 
 type graphql struct {
 	TestCaseRunner
+	// suite enables the schema validation, the schema comes from spec.url or the introspection
+	suite           *testing.TestSuite
+	testReporter    TestReporter
+	apiSuggestLimit int
 }
 
 func NewGraphQLRunner(parent TestCaseRunner) TestCaseRunner {
 	return &graphql{
-		TestCaseRunner: parent,
+		TestCaseRunner:  parent,
+		testReporter:    NewDiscardTestReporter(),
+		apiSuggestLimit: 10,
 	}
 }
 
@@ -48,9 +60,245 @@ func (r *graphql) RunTestCase(testcase *testing.TestCase, dataContext any, ctx c
 		testcase.Request.Header = make(map[string]string, 1)
 	}
 	testcase.Request.Header[util.ContentType] = util.JSON
+
+	// the rendered request is validated and sent, the HTTP runner does not render it again
+	contextDir := NewContextKeyBuilder().ParentDir().GetContextValueOrEmpty(ctx)
+	if err = testcase.Request.Render(dataContext, contextDir); err != nil {
+		return
+	}
+	ctx = context.WithValue(ctx, NewContextKeyBuilder().RequestRendered(), true)
+	request := testcase.Request
+
+	var gqlRequest *graphqlRequest
+	if gqlRequest, err = parseGraphQLRequest(request.Body.String()); err != nil {
+		return
+	}
+	if err = r.validate(ctx, request, gqlRequest); err != nil {
+		return
+	}
+
+	if isGraphQLSubscription(gqlRequest) {
+		return r.runSubscription(ctx, testcase, request.API, request.Header, gqlRequest, dataContext)
+	}
 	return r.TestCaseRunner.RunTestCase(testcase, dataContext, ctx)
 }
 
-func (s *graphql) WithSuite(suite *testing.TestSuite) {
-	// not need this parameter
+// validate checks the request against the schema, and records the coverage
+func (r *graphql) validate(ctx context.Context, request testing.Request, gqlRequest *graphqlRequest) (err error) {
+	if r.suite == nil {
+		return
+	}
+
+	source := r.suite.Spec.URL
+	client := util.TlsAwareHTTPClient(r.insecure())
+	var schema *ast.Schema
+	if schema, err = loadGraphQLSchema(ctx, client, source, request.API, request.Header); err != nil {
+		if source != "" {
+			return
+		}
+		// the introspection might be disabled by the server
+		runnerLogger.Info("skip the GraphQL validation due to the introspection failed", "api", request.API, "error", err.Error())
+		return nil
+	}
+
+	var doc *ast.QueryDocument
+	if doc, err = validateGraphQLRequest(schema, gqlRequest); err == nil {
+		defaultGraphQLCoverage.record(util.EmptyThenDefault(source, request.API), schema, doc, gqlRequest.OperationName)
+	}
+	return
+}
+
+func isGraphQLSubscription(request *graphqlRequest) bool {
+	doc, err := parser.ParseQuery(&ast.Source{Input: request.Query})
+	if err != nil {
+		return false
+	}
+	operation := doc.Operations.ForName(request.OperationName)
+	return operation != nil && operation.Operation == ast.Subscription
+}
+
+func (r *graphql) insecure() bool {
+	return r.suite != nil && r.suite.Spec.Secure != nil && r.suite.Spec.Secure.Insecure
+}
+
+// GetSuggestedAPIs generates the queries and mutations from the schema,
+// the api is taken as a filter of the field names
+func (r *graphql) GetSuggestedAPIs(suite *testing.TestSuite, api string) (result []*testing.TestCase, err error) {
+	var endpoint string
+	if endpoint, err = render.Render("base api", suite.API, map[string]any{
+		testing.ContextKeyGlobalParam: suite.Param,
+	}); err != nil {
+		return
+	}
+	endpoint = strings.TrimSuffix(strings.TrimSpace(endpoint), "/")
+
+	// the schema is loaded again for every suggestion
+	ctx, closeScope := WithRunScope(context.Background())
+	defer func() {
+		_ = closeScope()
+	}()
+
+	insecure := suite.Spec.Secure != nil && suite.Spec.Secure.Insecure
+	var schema *ast.Schema
+	if schema, err = loadGraphQLSchema(ctx, util.TlsAwareHTTPClient(insecure),
+		suite.Spec.URL, endpoint, nil); err != nil {
+		return
+	}
+
+	for _, root := range []*ast.Definition{schema.Query, schema.Mutation} {
+		if root == nil {
+			continue
+		}
+
+		operation := "query"
+		if root == schema.Mutation {
+			operation = "mutation"
+		}
+		for _, field := range root.Fields {
+			if strings.HasPrefix(field.Name, "__") || !strings.Contains(field.Name, api) {
+				continue
+			}
+
+			var body []byte
+			if body, err = json.Marshal(suggestGraphQLRequest(schema, operation, field)); err != nil {
+				return
+			}
+			result = append(result, &testing.TestCase{
+				Name: field.Name,
+				Request: testing.Request{
+					API:    endpoint,
+					Method: http.MethodPost,
+					Body:   testing.NewRequestBody(string(body)),
+				},
+			})
+			if len(result) >= r.apiSuggestLimit {
+				return
+			}
+		}
+	}
+	return
+}
+
+// the max depth of the generated selection sets and input objects
+const graphqlSuggestDepth = 2
+
+func suggestGraphQLRequest(schema *ast.Schema, operation string, field *ast.FieldDefinition) (
+	request *testing.GraphQLRequestBody) {
+	request = &testing.GraphQLRequestBody{
+		OperationName: field.Name,
+		Variables:     map[string]any{},
+	}
+
+	var definitions, arguments []string
+	for _, arg := range field.Arguments {
+		// only the required arguments are given
+		if !arg.Type.NonNull || arg.DefaultValue != nil {
+			continue
+		}
+		definitions = append(definitions, fmt.Sprintf("$%s: %s", arg.Name, arg.Type.String()))
+		arguments = append(arguments, fmt.Sprintf("%s: $%s", arg.Name, arg.Name))
+		request.Variables[arg.Name] = graphqlSampleValue(schema, arg.Type, 0)
+	}
+
+	query := &strings.Builder{}
+	query.WriteString(operation + " " + field.Name)
+	if len(definitions) > 0 {
+		query.WriteString("(" + strings.Join(definitions, ", ") + ")")
+	}
+	query.WriteString(" {\n  " + field.Name)
+	if len(arguments) > 0 {
+		query.WriteString("(" + strings.Join(arguments, ", ") + ")")
+	}
+	query.WriteString(graphqlSelectionSet(schema, field.Type, "  ", 0))
+	query.WriteString("\n}")
+	request.Query = query.String()
+	return
+}
+
+// graphqlSelectionSet selects the leaf fields which have no required arguments
+func graphqlSelectionSet(schema *ast.Schema, typ *ast.Type, indent string, depth int) string {
+	def := schema.Types[typ.Name()]
+	if def == nil || def.IsLeafType() {
+		return ""
+	}
+
+	var fields []string
+	if def.Kind == ast.Object || def.Kind == ast.Interface {
+		for _, field := range def.Fields {
+			if strings.HasPrefix(field.Name, "__") || hasRequiredArguments(field) {
+				continue
+			}
+			if fieldDef := schema.Types[field.Type.Name()]; fieldDef != nil && fieldDef.IsLeafType() {
+				fields = append(fields, field.Name)
+			} else if depth < graphqlSuggestDepth {
+				if selection := graphqlSelectionSet(schema, field.Type, indent+"  ", depth+1); selection != "" {
+					fields = append(fields, field.Name+selection)
+				}
+			}
+		}
+	}
+	if len(fields) == 0 {
+		fields = append(fields, "__typename")
+	}
+	return " {\n" + indent + "  " + strings.Join(fields, "\n"+indent+"  ") + "\n" + indent + "}"
+}
+
+func hasRequiredArguments(field *ast.FieldDefinition) bool {
+	for _, arg := range field.Arguments {
+		if arg.Type.NonNull && arg.DefaultValue == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// graphqlSampleValue generates a value of the input type
+func graphqlSampleValue(schema *ast.Schema, typ *ast.Type, depth int) any {
+	if typ.Elem != nil {
+		return []any{graphqlSampleValue(schema, typ.Elem, depth)}
+	}
+
+	switch typ.NamedType {
+	case "Int":
+		return 101
+	case "Float":
+		return 1.5
+	case "Boolean":
+		return true
+	case "ID", "String":
+		return "random"
+	}
+
+	def := schema.Types[typ.NamedType]
+	switch {
+	case def == nil:
+	case def.Kind == ast.Enum && len(def.EnumValues) > 0:
+		return def.EnumValues[0].Name
+	case def.Kind == ast.InputObject:
+		value := map[string]any{}
+		for _, field := range def.Fields {
+			if depth < graphqlSuggestDepth && field.Type.NonNull && field.DefaultValue == nil {
+				value[field.Name] = graphqlSampleValue(schema, field.Type, depth+1)
+			}
+		}
+		return value
+	}
+	return "random"
+}
+
+// WithSuite enables the schema validation of the test cases
+func (r *graphql) WithSuite(suite *testing.TestSuite) {
+	r.suite = suite
+	r.TestCaseRunner.WithSuite(suite)
+}
+
+// WithTestReporter sets the TestReporter
+func (r *graphql) WithTestReporter(reporter TestReporter) {
+	r.testReporter = reporter
+	r.TestCaseRunner.WithTestReporter(reporter)
+}
+
+func (r *graphql) WithAPISuggestLimit(limit int) {
+	r.apiSuggestLimit = limit
+	r.TestCaseRunner.WithAPISuggestLimit(limit)
 }
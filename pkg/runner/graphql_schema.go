@@ -0,0 +1,464 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+// graphqlIntrospectionQuery is the standard introspection query without the descriptions
+const graphqlIntrospectionQuery = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types { ...FullType }
+    directives {
+      name
+      locations
+      args { ...InputValue }
+    }
+  }
+}
+
+fragment FullType on __Type {
+  kind
+  name
+  fields(includeDeprecated: true) {
+    name
+    args { ...InputValue }
+    type { ...TypeRef }
+  }
+  inputFields { ...InputValue }
+  interfaces { ...TypeRef }
+  enumValues(includeDeprecated: true) { name }
+  possibleTypes { ...TypeRef }
+}
+
+fragment InputValue on __InputValue {
+  name
+  type { ...TypeRef }
+  defaultValue
+}
+
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name } } } } } } }
+}`
+
+type graphqlIntrospectionResult struct {
+	Data struct {
+		Schema *graphqlIntrospectionSchema `json:"__schema"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+type graphqlIntrospectionSchema struct {
+	QueryType        *graphqlTypeRef            `json:"queryType"`
+	MutationType     *graphqlTypeRef            `json:"mutationType"`
+	SubscriptionType *graphqlTypeRef            `json:"subscriptionType"`
+	Types            []graphqlIntrospectionType `json:"types"`
+	Directives       []struct {
+		Name      string              `json:"name"`
+		Locations []string            `json:"locations"`
+		Args      []graphqlInputValue `json:"args"`
+	} `json:"directives"`
+}
+
+type graphqlIntrospectionType struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Fields []struct {
+		Name string              `json:"name"`
+		Args []graphqlInputValue `json:"args"`
+		Type graphqlTypeRef      `json:"type"`
+	} `json:"fields"`
+	InputFields   []graphqlInputValue     `json:"inputFields"`
+	Interfaces    []graphqlTypeRef        `json:"interfaces"`
+	EnumValues    []struct{ Name string } `json:"enumValues"`
+	PossibleTypes []graphqlTypeRef        `json:"possibleTypes"`
+}
+
+type graphqlInputValue struct {
+	Name         string         `json:"name"`
+	Type         graphqlTypeRef `json:"type"`
+	DefaultValue *string        `json:"defaultValue"`
+}
+
+type graphqlTypeRef struct {
+	Kind   string          `json:"kind"`
+	Name   string          `json:"name"`
+	OfType *graphqlTypeRef `json:"ofType"`
+}
+
+func (t graphqlTypeRef) String() string {
+	switch t.Kind {
+	case "NON_NULL":
+		if t.OfType != nil {
+			return t.OfType.String() + "!"
+		}
+	case "LIST":
+		if t.OfType != nil {
+			return "[" + t.OfType.String() + "]"
+		}
+	}
+	return t.Name
+}
+
+// the types and directives which are provided by the prelude of the parser
+var graphqlBuiltinNames = map[string]bool{
+	"Int": true, "Float": true, "String": true, "Boolean": true, "ID": true,
+	"defer": true, "include": true, "skip": true, "deprecated": true, "specifiedBy": true, "oneOf": true,
+}
+
+// toSDL converts the introspection result to the schema definition language
+func (s *graphqlIntrospectionSchema) toSDL() string {
+	buf := &strings.Builder{}
+	buf.WriteString("schema {\n")
+	for i, ref := range []*graphqlTypeRef{s.QueryType, s.MutationType, s.SubscriptionType} {
+		if ref != nil && ref.Name != "" {
+			fmt.Fprintf(buf, "  %s: %s\n", []string{"query", "mutation", "subscription"}[i], ref.Name)
+		}
+	}
+	buf.WriteString("}\n")
+
+	for _, directive := range s.Directives {
+		if graphqlBuiltinNames[directive.Name] || len(directive.Locations) == 0 {
+			continue
+		}
+		fmt.Fprintf(buf, "\ndirective @%s%s on %s\n", directive.Name, inputValuesToSDL(directive.Args),
+			strings.Join(directive.Locations, " | "))
+	}
+
+	for _, item := range s.Types {
+		if strings.HasPrefix(item.Name, "__") || graphqlBuiltinNames[item.Name] {
+			continue
+		}
+
+		buf.WriteString("\n")
+		switch item.Kind {
+		case "SCALAR":
+			fmt.Fprintf(buf, "scalar %s\n", item.Name)
+		case "OBJECT", "INTERFACE":
+			keyword := "type"
+			if item.Kind == "INTERFACE" {
+				keyword = "interface"
+			}
+			fmt.Fprintf(buf, "%s %s", keyword, item.Name)
+			if len(item.Interfaces) > 0 {
+				var names []string
+				for _, ref := range item.Interfaces {
+					names = append(names, ref.Name)
+				}
+				fmt.Fprintf(buf, " implements %s", strings.Join(names, " & "))
+			}
+			buf.WriteString(" {\n")
+			for _, field := range item.Fields {
+				fmt.Fprintf(buf, "  %s%s: %s\n", field.Name, inputValuesToSDL(field.Args), field.Type.String())
+			}
+			buf.WriteString("}\n")
+		case "UNION":
+			var names []string
+			for _, ref := range item.PossibleTypes {
+				names = append(names, ref.Name)
+			}
+			fmt.Fprintf(buf, "union %s = %s\n", item.Name, strings.Join(names, " | "))
+		case "ENUM":
+			fmt.Fprintf(buf, "enum %s {\n", item.Name)
+			for _, value := range item.EnumValues {
+				fmt.Fprintf(buf, "  %s\n", value.Name)
+			}
+			buf.WriteString("}\n")
+		case "INPUT_OBJECT":
+			fmt.Fprintf(buf, "input %s {\n", item.Name)
+			for _, field := range item.InputFields {
+				fmt.Fprintf(buf, "  %s\n", inputValueToSDL(field))
+			}
+			buf.WriteString("}\n")
+		}
+	}
+	return buf.String()
+}
+
+func inputValuesToSDL(values []graphqlInputValue) string {
+	if len(values) == 0 {
+		return ""
+	}
+	var items []string
+	for _, value := range values {
+		items = append(items, inputValueToSDL(value))
+	}
+	return "(" + strings.Join(items, ", ") + ")"
+}
+
+func inputValueToSDL(value graphqlInputValue) (result string) {
+	result = value.Name + ": " + value.Type.String()
+	if value.DefaultValue != nil {
+		result += " = " + *value.DefaultValue
+	}
+	return
+}
+
+// parseGraphQLSchema parses the schema from the SDL or the JSON introspection result
+func parseGraphQLSchema(name string, data []byte) (schema *ast.Schema, err error) {
+	sdl := string(data)
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		result := &graphqlIntrospectionResult{}
+		if err = json.Unmarshal(trimmed, result); err != nil {
+			err = fmt.Errorf("failed to parse the introspection result: %v", err)
+			return
+		}
+		if len(result.Errors) > 0 {
+			err = fmt.Errorf("failed to introspect the schema: %s", result.Errors[0].Message)
+			return
+		}
+		if result.Data.Schema == nil {
+			err = errors.New("no schema is found in the introspection result")
+			return
+		}
+		sdl = result.Data.Schema.toSDL()
+	}
+
+	if schema, err = gqlparser.LoadSchema(&ast.Source{Name: name, Input: sdl}); err != nil {
+		err = fmt.Errorf("failed to load the GraphQL schema from %q: %v", name, err)
+	}
+	return
+}
+
+// loadGraphQLSchema loads the schema from a local file or URL, it introspects
+// the endpoint when the source is empty. The schemas are cached by the source in the run scope.
+func loadGraphQLSchema(ctx context.Context, client *http.Client, source, endpoint string,
+	header map[string]string) (schema *ast.Schema, err error) {
+	key := source
+	if source == "" {
+		key = "introspection:" + endpoint
+	}
+
+	var value any
+	if value, err = getRunScope(ctx).graphqlSchemas.load(key, func() (value any, digests map[string]string, err error) {
+		var data []byte
+		switch {
+		case source == "":
+			var body []byte
+			if body, err = json.Marshal(map[string]string{"query": graphqlIntrospectionQuery}); err == nil {
+				data, err = requestGraphQLSchema(ctx, client, http.MethodPost, endpoint, body, header)
+			}
+		case strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://"):
+			data, err = requestGraphQLSchema(ctx, client, http.MethodGet, source, nil, nil)
+		default:
+			if data, err = os.ReadFile(source); err == nil {
+				digests = map[string]string{source: contentDigest(data)}
+			}
+		}
+
+		if err == nil {
+			value, err = parseGraphQLSchema(util.EmptyThenDefault(source, endpoint), data)
+		}
+		return
+	}); err == nil {
+		schema = value.(*ast.Schema)
+	}
+	return
+}
+
+func requestGraphQLSchema(ctx context.Context, client *http.Client, method, api string, body []byte,
+	header map[string]string) (data []byte, err error) {
+	var req *http.Request
+	if req, err = http.NewRequestWithContext(ctx, method, api, bytes.NewReader(body)); err != nil {
+		return
+	}
+	for key, value := range header {
+		req.Header.Set(key, value)
+	}
+	if body != nil {
+		req.Header.Set(util.ContentType, util.JSON)
+	}
+
+	var resp *http.Response
+	if resp, err = client.Do(req); err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	if data, err = io.ReadAll(resp.Body); err == nil && resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("failed to get the GraphQL schema from %q, status code: %d", api, resp.StatusCode)
+	}
+	return
+}
+
+// graphqlRequest is the GraphQL request body with the typed variables
+type graphqlRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func parseGraphQLRequest(body string) (request *graphqlRequest, err error) {
+	request = &graphqlRequest{}
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	if err = decoder.Decode(request); err != nil {
+		err = fmt.Errorf("the GraphQL request body is not a valid JSON: %v", err)
+	}
+	return
+}
+
+// validateGraphQLRequest checks the query and the variables against the schema
+func validateGraphQLRequest(schema *ast.Schema, request *graphqlRequest) (doc *ast.QueryDocument, err error) {
+	var errs gqlerror.List
+	if doc, errs = gqlparser.LoadQueryWithRules(schema, request.Query, nil); len(errs) > 0 {
+		err = fmt.Errorf("invalid GraphQL query: %v", errs)
+		return
+	}
+
+	operation := doc.Operations.ForName(request.OperationName)
+	if operation == nil {
+		err = fmt.Errorf("operation %q is not found in the query", request.OperationName)
+		return
+	}
+	if _, err = validator.VariableValues(schema, operation, request.Variables); err != nil {
+		err = fmt.Errorf("invalid GraphQL variables: %v", err)
+	}
+	return
+}
+
+// GraphQLCoverage is the coverage of the object types and fields of a GraphQL schema
+type GraphQLCoverage struct {
+	Schema        string
+	CoveredTypes  int
+	TotalTypes    int
+	CoveredFields int
+	TotalFields   int
+	// Uncovered are the fields which are not exercised, such as: Query.books
+	Uncovered []string
+}
+
+type graphqlSchemaCoverage struct {
+	schema  *ast.Schema
+	covered map[string]bool
+}
+
+// graphqlCoverageRecorder records the fields which are selected by the sent queries
+type graphqlCoverageRecorder struct {
+	mu      sync.Mutex
+	schemas map[string]*graphqlSchemaCoverage
+}
+
+var defaultGraphQLCoverage = &graphqlCoverageRecorder{
+	schemas: map[string]*graphqlSchemaCoverage{},
+}
+
+func (c *graphqlCoverageRecorder) record(name string, schema *ast.Schema, doc *ast.QueryDocument, operationName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	coverage, ok := c.schemas[name]
+	if !ok || coverage.schema != schema {
+		coverage = &graphqlSchemaCoverage{schema: schema, covered: map[string]bool{}}
+		c.schemas[name] = coverage
+	}
+	if operation := doc.Operations.ForName(operationName); operation != nil {
+		recordSelectionSet(coverage.covered, operation.SelectionSet)
+	}
+}
+
+func recordSelectionSet(covered map[string]bool, selections ast.SelectionSet) {
+	for _, selection := range selections {
+		switch item := selection.(type) {
+		case *ast.Field:
+			if item.ObjectDefinition != nil && !strings.HasPrefix(item.Name, "__") {
+				covered[item.ObjectDefinition.Name+"."+item.Name] = true
+			}
+			recordSelectionSet(covered, item.SelectionSet)
+		case *ast.InlineFragment:
+			recordSelectionSet(covered, item.SelectionSet)
+		case *ast.FragmentSpread:
+			if item.Definition != nil {
+				recordSelectionSet(covered, item.Definition.SelectionSet)
+			}
+		}
+	}
+}
+
+func (c *graphqlCoverageRecorder) reset() {
+	c.mu.Lock()
+	c.schemas = map[string]*graphqlSchemaCoverage{}
+	c.mu.Unlock()
+}
+
+func (c *graphqlCoverageRecorder) list() (result []GraphQLCoverage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for name, coverage := range c.schemas {
+		item := GraphQLCoverage{Schema: name}
+		for _, def := range coverage.schema.Types {
+			if def.BuiltIn || strings.HasPrefix(def.Name, "__") ||
+				(def.Kind != ast.Object && def.Kind != ast.Interface) {
+				continue
+			}
+
+			item.TotalTypes++
+			typeCovered := false
+			for _, field := range def.Fields {
+				if strings.HasPrefix(field.Name, "__") {
+					continue
+				}
+				item.TotalFields++
+				if coverage.covered[def.Name+"."+field.Name] {
+					item.CoveredFields++
+					typeCovered = true
+				} else {
+					item.Uncovered = append(item.Uncovered, def.Name+"."+field.Name)
+				}
+			}
+			if typeCovered {
+				item.CoveredTypes++
+			}
+		}
+		sort.Strings(item.Uncovered)
+		result = append(result, item)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Schema < result[j].Schema
+	})
+	return
+}
+
+// GetGraphQLCoverage returns the coverage of the GraphQL schemas which the test cases were validated against
+func GetGraphQLCoverage() []GraphQLCoverage {
+	return defaultGraphQLCoverage.list()
+}
@@ -0,0 +1,363 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/linuxsuren/api-testing/pkg/compare"
+	"github.com/linuxsuren/api-testing/pkg/render"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/tidwall/gjson"
+)
+
+// graphqlWSProtocol is the sub-protocol of graphql-ws, see also
+// https://github.com/enisdenjo/graphql-ws/blob/master/PROTOCOL.md
+const graphqlWSProtocol = "graphql-transport-ws"
+
+// the message types of graphql-ws
+const (
+	graphqlWSConnectionInit = "connection_init"
+	graphqlWSConnectionAck  = "connection_ack"
+	graphqlWSPing           = "ping"
+	graphqlWSPong           = "pong"
+	graphqlWSSubscribe      = "subscribe"
+	graphqlWSNext           = "next"
+	graphqlWSError          = "error"
+	graphqlWSComplete       = "complete"
+)
+
+const graphqlWSSubscriptionID = "1"
+
+type graphqlWSMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type graphqlWSEvent struct {
+	payload json.RawMessage
+	err     error
+}
+
+// graphqlSubscription receives the events of a subscription in background
+type graphqlSubscription struct {
+	conn *websocket.Conn
+	// writeMu guards the writing, the pong is written by the receiving goroutine
+	writeMu  sync.Mutex
+	events   chan graphqlWSEvent
+	payloads []json.RawMessage
+	// done is true once the server completed the subscription
+	done bool
+}
+
+// runSubscription subscribes over graphql-ws, the received payloads are verified as a JSON array.
+// The stream steps could expect the payloads one by one, or all the payloads are collected until
+// the server completes the subscription.
+func (r *graphql) runSubscription(ctx context.Context, testcase *testing.TestCase, api string,
+	header map[string]string, request *graphqlRequest, dataContext any) (output any, err error) {
+	record := NewReportRecord()
+	defer func(rr *ReportRecord) {
+		rr.Group = testcase.Group
+		rr.Name = testcase.Name
+		rr.EndTime = time.Now()
+		rr.Error = err
+		rr.API = api
+		rr.Method = "subscription"
+		r.testReporter.PutRecord(rr)
+	}(record)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var subscription *graphqlSubscription
+	var resp *http.Response
+	if subscription, resp, err = r.subscribe(ctx, api, request, header); err != nil {
+		return
+	}
+	defer subscription.conn.Close()
+	go subscription.receive(ctx)
+
+	if err = runJob(ctx, testcase.Before, dataContext, nil); err != nil {
+		return
+	}
+
+	for i, step := range testcase.Stream {
+		if err = subscription.run(ctx, fmt.Sprintf("%s step %d", testcase.Name, i+1), step, dataContext); err != nil {
+			err = fmt.Errorf("step %d %s: %v", i+1, step.Kind(), err)
+			return
+		}
+	}
+	if len(testcase.Stream) == 0 {
+		err = subscription.collect(ctx, defaultStreamStepTimeout)
+	}
+	if !subscription.done {
+		_ = subscription.write(&graphqlWSMessage{ID: graphqlWSSubscriptionID, Type: graphqlWSComplete})
+	}
+	if err != nil {
+		return
+	}
+
+	var body []byte
+	if body, err = json.Marshal(subscription.payloads); err != nil {
+		return
+	}
+	record.Body = string(body)
+
+	if err = testcase.Expect.Render(dataContext); err != nil {
+		return
+	}
+	if output, err = verifyResponseBodyData(testcase.Name, testcase.Expect, util.JSON, body); err == nil {
+		err = jsonSchemaValidation(testcase.Expect.Schema, body)
+	}
+	if err == nil {
+		err = captureVariables(testcase, resp, body, dataContext)
+	}
+	if err == nil {
+		err = runJob(ctx, testcase.After, dataContext, output)
+	}
+	return
+}
+
+// subscribe connects to the endpoint and starts the subscription
+func (r *graphql) subscribe(ctx context.Context, api string, request *graphqlRequest,
+	header map[string]string) (subscription *graphqlSubscription, resp *http.Response, err error) {
+	endpoint := api
+	switch {
+	case strings.HasPrefix(endpoint, "http://"):
+		endpoint = "ws://" + strings.TrimPrefix(endpoint, "http://")
+	case strings.HasPrefix(endpoint, "https://"):
+		endpoint = "wss://" + strings.TrimPrefix(endpoint, "https://")
+	}
+
+	// the headers are sent in the handshake and the connection init payload both
+	requestHeader := http.Header{}
+	params := map[string]string{}
+	for key, value := range header {
+		if !strings.EqualFold(key, util.ContentType) {
+			requestHeader.Set(key, value)
+			params[key] = value
+		}
+	}
+
+	dialer := &websocket.Dialer{
+		Subprotocols:     []string{graphqlWSProtocol},
+		HandshakeTimeout: defaultStreamStepTimeout,
+		TLSClientConfig:  &tls.Config{InsecureSkipVerify: r.insecure()},
+	}
+	var conn *websocket.Conn
+	if conn, resp, err = dialer.DialContext(ctx, endpoint, requestHeader); err != nil {
+		err = fmt.Errorf("failed to connect to %q: %v", endpoint, err)
+		return
+	}
+
+	var payload []byte
+	if payload, err = json.Marshal(params); err == nil {
+		err = conn.WriteJSON(&graphqlWSMessage{Type: graphqlWSConnectionInit, Payload: payload})
+	}
+	if err == nil {
+		err = waitConnectionAck(conn, defaultStreamStepTimeout)
+	}
+	if err == nil {
+		if payload, err = json.Marshal(request); err == nil {
+			err = conn.WriteJSON(&graphqlWSMessage{ID: graphqlWSSubscriptionID, Type: graphqlWSSubscribe, Payload: payload})
+		}
+	}
+
+	if err != nil {
+		_ = conn.Close()
+		return
+	}
+	subscription = &graphqlSubscription{
+		conn:   conn,
+		events: make(chan graphqlWSEvent),
+	}
+	return
+}
+
+func waitConnectionAck(conn *websocket.Conn, timeout time.Duration) (err error) {
+	if err = conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		return
+	}
+	for {
+		message := &graphqlWSMessage{}
+		if err = conn.ReadJSON(message); err != nil {
+			err = fmt.Errorf("failed to wait for the connection ack: %v", err)
+			return
+		}
+
+		switch message.Type {
+		case graphqlWSConnectionAck:
+			return conn.SetReadDeadline(time.Time{})
+		case graphqlWSPing:
+			if err = conn.WriteJSON(&graphqlWSMessage{Type: graphqlWSPong}); err != nil {
+				return
+			}
+		default:
+			err = fmt.Errorf("unexpected message %q before the connection ack", message.Type)
+			return
+		}
+	}
+}
+
+func (s *graphqlSubscription) receive(ctx context.Context) {
+	defer close(s.events)
+	for {
+		var event graphqlWSEvent
+		message := &graphqlWSMessage{}
+		if err := s.conn.ReadJSON(message); err != nil {
+			event.err = err
+		} else {
+			switch message.Type {
+			case graphqlWSPing:
+				if err = s.write(&graphqlWSMessage{Type: graphqlWSPong}); err != nil {
+					event.err = err
+				} else {
+					continue
+				}
+			case graphqlWSNext:
+				event.payload = message.Payload
+			case graphqlWSError:
+				event.err = fmt.Errorf("subscription failed: %s", string(message.Payload))
+			case graphqlWSComplete:
+				event.err = errGraphQLSubscriptionComplete
+			default:
+				continue
+			}
+		}
+
+		select {
+		case s.events <- event:
+		case <-ctx.Done():
+			return
+		}
+		if event.err != nil {
+			return
+		}
+	}
+}
+
+func (s *graphqlSubscription) write(message *graphqlWSMessage) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	return s.conn.WriteJSON(message)
+}
+
+var errGraphQLSubscriptionComplete = errors.New("the subscription is completed")
+
+// next returns the next payload, it fails if there is no payload in time
+func (s *graphqlSubscription) next(ctx context.Context, timeout time.Duration) (payload json.RawMessage, err error) {
+	if s.done {
+		err = errGraphQLSubscriptionComplete
+		return
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case event, ok := <-s.events:
+		switch {
+		case !ok:
+			err = ctx.Err()
+		case event.err != nil:
+			err = event.err
+			s.done = true
+		default:
+			payload = event.payload
+			s.payloads = append(s.payloads, payload)
+		}
+	case <-timer.C:
+		err = fmt.Errorf("no payload received in %v", timeout)
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	return
+}
+
+// collect receives the payloads until the server completes the subscription,
+// it fails if the subscription is not completed in time
+func (s *graphqlSubscription) collect(ctx context.Context, timeout time.Duration) (err error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for !s.done {
+		select {
+		case event, ok := <-s.events:
+			switch {
+			case !ok:
+				return ctx.Err()
+			case event.err != nil:
+				s.done = true
+				if !errors.Is(event.err, errGraphQLSubscriptionComplete) {
+					return event.err
+				}
+			default:
+				s.payloads = append(s.payloads, event.payload)
+			}
+		case <-timer.C:
+			return fmt.Errorf("the subscription is not completed by the server in %v", timeout)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return
+}
+
+func (s *graphqlSubscription) run(ctx context.Context, name string, step testing.StreamStep, dataContext any) (err error) {
+	timeout := defaultStreamStepTimeout
+	if step.Timeout != "" {
+		if timeout, err = time.ParseDuration(step.Timeout); err != nil {
+			err = fmt.Errorf("invalid timeout: %v", err)
+			return
+		}
+	}
+
+	switch step.Kind() {
+	case "expect":
+		var expect string
+		var payload json.RawMessage
+		if expect, err = render.Render("subscription expect", step.Expect, dataContext); err != nil {
+			return
+		}
+		if payload, err = s.next(ctx, timeout); err == nil {
+			err = compare.Object(name, gjson.Parse(expect).Map(), gjson.ParseBytes(payload).Map())
+		}
+	case "wait":
+		var duration time.Duration
+		if duration, err = time.ParseDuration(step.Wait); err != nil {
+			err = fmt.Errorf("invalid wait duration: %v", err)
+			return
+		}
+		select {
+		case <-time.After(duration):
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	default:
+		err = errors.New("only expect and wait are supported by the subscription")
+	}
+	return
+}
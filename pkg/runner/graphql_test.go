@@ -17,14 +17,26 @@ limitations under the License.
 package runner
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/h2non/gock"
 	atest "github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/linuxsuren/api-testing/pkg/util"
 	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
 	"gopkg.in/yaml.v3"
 )
 
@@ -42,6 +54,19 @@ func TestGraphQL(t *testing.T) {
 		Reply(http.StatusOK)
 	_, err = graphqlRunner.RunTestCase(testcase, nil, context.TODO())
 	assert.NoError(t, err)
+
+	t.Run("render once", func(t *testing.T) {
+		gock.New("http://foo").Post("/graphql").Reply(http.StatusOK)
+		testcase := &atest.TestCase{
+			Request: atest.Request{
+				API:  "http://foo/graphql",
+				Body: atest.NewRequestBody(`{"query":"{ bookById(id: \"{{print "{{"}}\") { id } }"}`),
+			},
+		}
+		_, err := graphqlRunner.RunTestCase(testcase, nil, context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, `{"query":"{ bookById(id: \"{{\") { id } }"}`, testcase.Request.Body.String())
+	})
 }
 
 var simpleGraphQLRequest = `
@@ -56,3 +81,313 @@ request:
         }
       }
 `
+
+func TestGraphQLValidation(t *testing.T) {
+	defaultGraphQLCoverage.reset()
+	server := newGraphQLServer(t)
+	defer server.Close()
+
+	graphqlRunner := NewGraphQLRunner(NewSimpleTestCaseRunner())
+	graphqlRunner.WithSuite(&atest.TestSuite{Spec: atest.APISpec{Kind: "graphql"}})
+
+	newCase := func(body string) *atest.TestCase {
+		return &atest.TestCase{
+			Name: "book",
+			Request: atest.Request{
+				API:  server.URL,
+				Body: atest.NewRequestBody(body),
+			},
+			Expect: atest.Response{
+				Verify: []string{`data.data.book.id == "1"`},
+			},
+		}
+	}
+
+	_, err := graphqlRunner.RunTestCase(newCase(`{"query":"query book($id: ID!) { book(id: $id) { id name } }","variables":{"id":"{{print 1}}"}}`),
+		nil, context.TODO())
+	assert.NoError(t, err)
+
+	_, err = graphqlRunner.RunTestCase(newCase(`{"query":"{ book(id: \"1\") { id title } }"}`), nil, context.TODO())
+	assert.ErrorContains(t, err, `Cannot query field "title" on type "Book"`)
+
+	_, err = graphqlRunner.RunTestCase(newCase(`{"query":"query books($limit: Int) { books(limit: $limit) { id } }","variables":{"limit":"ten"}}`),
+		nil, context.TODO())
+	assert.ErrorContains(t, err, "invalid GraphQL variables")
+
+	_, err = graphqlRunner.RunTestCase(newCase(`{"query":"query book($id: ID!) { book(id: $id) { id } }"}`), nil, context.TODO())
+	assert.ErrorContains(t, err, "must be defined")
+
+	_, err = graphqlRunner.RunTestCase(newCase("invalid"), nil, context.TODO())
+	assert.Error(t, err)
+
+	coverage := GetGraphQLCoverage()
+	if assert.Len(t, coverage, 1) {
+		assert.Equal(t, server.URL, coverage[0].Schema)
+		assert.Equal(t, 2, coverage[0].CoveredTypes)
+		assert.Equal(t, 6, coverage[0].TotalTypes)
+		assert.Equal(t, 3, coverage[0].CoveredFields)
+		assert.Equal(t, 13, coverage[0].TotalFields)
+		assert.Contains(t, coverage[0].Uncovered, "Mutation.addBook")
+		assert.NotContains(t, coverage[0].Uncovered, "Book.name")
+	}
+
+	t.Run("schema file", func(t *testing.T) {
+		schemaFile := filepath.Join(t.TempDir(), "schema.graphql")
+		assert.NoError(t, os.WriteFile(schemaFile, []byte(`type Query { book(id: ID!): Book }
+type Book { id: ID! }`), 0644))
+
+		fileRunner := NewGraphQLRunner(NewSimpleTestCaseRunner())
+		fileRunner.WithSuite(&atest.TestSuite{Spec: atest.APISpec{Kind: "graphql", URL: schemaFile}})
+		_, err := fileRunner.RunTestCase(newCase(`{"query":"{ book(id: \"1\") { id name } }"}`), nil, context.TODO())
+		assert.ErrorContains(t, err, `Cannot query field "name" on type "Book"`)
+
+		// the schema is loaded again once the file changed
+		assert.NoError(t, os.WriteFile(schemaFile, []byte(`type Query { book(id: ID!): Book }
+type Book { id: ID! name: String }`), 0644))
+		_, err = fileRunner.RunTestCase(newCase(`{"query":"{ book(id: \"1\") { id name } }"}`), nil, context.TODO())
+		assert.NoError(t, err)
+
+		fileRunner.WithSuite(&atest.TestSuite{Spec: atest.APISpec{Kind: "graphql", URL: "fake.graphql"}})
+		_, err = fileRunner.RunTestCase(newCase(`{"query":"{ book(id: \"1\") { id } }"}`), nil, context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("introspection is disabled", func(t *testing.T) {
+		disabled := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			data, _ := io.ReadAll(r.Body)
+			if strings.Contains(string(data), "__schema") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Header().Set(util.ContentType, util.JSON)
+			_, _ = w.Write([]byte(`{"data":{"book":{"id":"1"}}}`))
+		}))
+		defer disabled.Close()
+
+		testcase := newCase(`{"query":"{ book(id: \"1\") { id title } }"}`)
+		testcase.Request.API = disabled.URL
+		_, err := graphqlRunner.RunTestCase(testcase, nil, context.TODO())
+		assert.NoError(t, err)
+	})
+
+	t.Run("the schema is cached in a run", func(t *testing.T) {
+		var introspectCount atomic.Int32
+		counted := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			data, _ := io.ReadAll(r.Body)
+			if strings.Contains(string(data), "__schema") {
+				introspectCount.Add(1)
+			}
+			r.Body = io.NopCloser(bytes.NewReader(data))
+			server.Config.Handler.ServeHTTP(w, r)
+		}))
+		defer counted.Close()
+
+		run := func(ctx context.Context) {
+			testcase := newCase(`{"query":"{ book(id: \"1\") { id } }"}`)
+			testcase.Request.API = counted.URL
+			_, err := graphqlRunner.RunTestCase(testcase, nil, ctx)
+			assert.NoError(t, err)
+		}
+
+		ctx, closeScope := WithRunScope(context.Background())
+		run(ctx)
+		run(ctx)
+		assert.Equal(t, int32(1), introspectCount.Load())
+		assert.NoError(t, closeScope())
+
+		ctx, closeScope = WithRunScope(context.Background())
+		defer closeScope()
+		run(ctx)
+		assert.Equal(t, int32(2), introspectCount.Load(), "the schema is not cached across the runs")
+	})
+}
+
+func TestGraphQLSubscription(t *testing.T) {
+	server := newGraphQLServer(t)
+	defer server.Close()
+
+	graphqlRunner := NewGraphQLRunner(NewSimpleTestCaseRunner())
+	graphqlRunner.WithSuite(&atest.TestSuite{Spec: atest.APISpec{Kind: "graphql"}})
+	newCase := func() *atest.TestCase {
+		return &atest.TestCase{
+			Name: "bookAdded",
+			Request: atest.Request{
+				API:    server.URL,
+				Header: map[string]string{"Authorization": "Bearer token"},
+				Body:   atest.NewRequestBody(`{"query":"subscription { bookAdded { id name } }"}`),
+			},
+		}
+	}
+
+	t.Run("collect", func(t *testing.T) {
+		testcase := newCase()
+		testcase.Expect.Verify = []string{
+			`len(data) == 2`,
+			`data[1].data.bookAdded.id == "2"`,
+		}
+		_, err := graphqlRunner.RunTestCase(testcase, nil, context.TODO())
+		assert.NoError(t, err)
+	})
+
+	t.Run("steps", func(t *testing.T) {
+		testcase := newCase()
+		testcase.Stream = []atest.StreamStep{
+			{Expect: `{"data": {"bookAdded": {"id": "1"}}}`},
+			{Expect: `{"data": {"bookAdded": {"id": "{{print 2}}"}}}`},
+		}
+		_, err := graphqlRunner.RunTestCase(testcase, nil, context.TODO())
+		assert.NoError(t, err)
+
+		testcase.Stream = []atest.StreamStep{{Expect: `{"data": {"bookAdded": {"id": "2"}}}`}}
+		_, err = graphqlRunner.RunTestCase(testcase, nil, context.TODO())
+		assert.Error(t, err)
+
+		testcase.Stream = []atest.StreamStep{{Send: `{}`}}
+		_, err = graphqlRunner.RunTestCase(testcase, nil, context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("not completed in time", func(t *testing.T) {
+		subscription := &graphqlSubscription{events: make(chan graphqlWSEvent)}
+		err := subscription.collect(context.TODO(), 10*time.Millisecond)
+		assert.ErrorContains(t, err, "the subscription is not completed by the server in 10ms")
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		testcase := newCase()
+		testcase.Request.Header = nil
+		_, err := graphqlRunner.RunTestCase(testcase, nil, context.TODO())
+		assert.ErrorContains(t, err, "unauthorized")
+	})
+}
+
+func TestGraphQLSuggestedAPIs(t *testing.T) {
+	server := newGraphQLServer(t)
+	defer server.Close()
+
+	suite := &atest.TestSuite{
+		API:  server.URL + "/",
+		Spec: atest.APISpec{Kind: "graphql"},
+	}
+	graphqlRunner := NewGraphQLRunner(NewSimpleTestCaseRunner())
+	result, err := graphqlRunner.GetSuggestedAPIs(suite, "")
+	assert.NoError(t, err)
+
+	var names []string
+	for _, item := range result {
+		names = append(names, item.Name)
+		assert.Equal(t, server.URL, item.Request.API)
+	}
+	assert.Equal(t, []string{"book", "books", "search", "addBook"}, names)
+
+	// the suggested requests are valid
+	schema, err := loadGraphQLSchema(context.TODO(), http.DefaultClient, "", server.URL, nil)
+	assert.NoError(t, err)
+	for _, item := range result {
+		request, err := parseGraphQLRequest(item.Request.Body.String())
+		assert.NoError(t, err)
+		_, err = validateGraphQLRequest(schema, request)
+		assert.NoError(t, err, item.Name)
+	}
+
+	book := result[0].Request.Body.String()
+	assert.Contains(t, book, `query book($id: ID!)`)
+	assert.Contains(t, book, `"variables":{"id":"random"}`)
+	assert.Contains(t, result[3].Request.Body.String(), `"variables":{"input":{"genre":"NOVEL","name":"random"}}`)
+
+	graphqlRunner.WithAPISuggestLimit(1)
+	result, err = graphqlRunner.GetSuggestedAPIs(suite, "add")
+	assert.NoError(t, err)
+	if assert.Len(t, result, 1) {
+		assert.Equal(t, "addBook", result[0].Name)
+	}
+
+	_, err = graphqlRunner.GetSuggestedAPIs(&atest.TestSuite{Spec: atest.APISpec{URL: "fake.graphql"}}, "")
+	assert.Error(t, err)
+}
+
+func TestGraphQLIntrospectionToSDL(t *testing.T) {
+	data, err := os.ReadFile("testdata/graphql-introspection.json")
+	assert.NoError(t, err)
+
+	schema, err := parseGraphQLSchema("introspection", data)
+	assert.NoError(t, err)
+	assert.Equal(t, "Subscription", schema.Subscription.Name)
+	assert.NotNil(t, schema.Directives["cached"])
+	assert.Equal(t, ast.Scalar, schema.Types["Date"].Kind)
+	assert.Equal(t, []string{"Node"}, schema.Types["Book"].Interfaces)
+	assert.Equal(t, "10", schema.Query.Fields.ForName("books").Arguments.ForName("limit").DefaultValue.String())
+
+	_, err = parseGraphQLSchema("error", []byte(`{"errors":[{"message":"introspection is disabled"}]}`))
+	assert.ErrorContains(t, err, "introspection is disabled")
+
+	_, err = parseGraphQLSchema("empty", []byte(`{"data":{}}`))
+	assert.Error(t, err)
+
+	_, err = parseGraphQLSchema("invalid", []byte(`type Query {`))
+	assert.Error(t, err)
+}
+
+// newGraphQLServer serves the schema of the introspection, the book query and the bookAdded subscription
+func newGraphQLServer(t *testing.T) *httptest.Server {
+	introspection, err := os.ReadFile("testdata/graphql-introspection.json")
+	assert.NoError(t, err)
+
+	upgrader := &websocket.Upgrader{Subprotocols: []string{graphqlWSProtocol}}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if websocket.IsWebSocketUpgrade(r) {
+			serveGraphQLSubscription(upgrader, w, r)
+			return
+		}
+
+		request := &graphqlRequest{}
+		_ = json.NewDecoder(r.Body).Decode(request)
+		w.Header().Set(util.ContentType, util.JSON)
+		if strings.Contains(request.Query, "__schema") {
+			_, _ = w.Write(introspection)
+			return
+		}
+		id, ok := request.Variables["id"]
+		if !ok {
+			id = "1"
+		}
+		_, _ = fmt.Fprintf(w, `{"data":{"book":{"id":"%v","name":"api-testing"}}}`, id)
+	}))
+}
+
+func serveGraphQLSubscription(upgrader *websocket.Upgrader, w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	message := &graphqlWSMessage{}
+	if err = conn.ReadJSON(message); err != nil || message.Type != graphqlWSConnectionInit {
+		return
+	}
+	if !strings.Contains(string(message.Payload), "Bearer token") {
+		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(4403, "unauthorized"))
+		return
+	}
+	_ = conn.WriteJSON(&graphqlWSMessage{Type: graphqlWSPing})
+	_ = conn.WriteJSON(&graphqlWSMessage{Type: graphqlWSConnectionAck})
+
+	for {
+		if err = conn.ReadJSON(message); err != nil {
+			return
+		}
+		if message.Type == graphqlWSSubscribe {
+			break
+		}
+	}
+	for i := 1; i <= 2; i++ {
+		_ = conn.WriteJSON(&graphqlWSMessage{
+			ID:      message.ID,
+			Type:    graphqlWSNext,
+			Payload: json.RawMessage(fmt.Sprintf(`{"data":{"bookAdded":{"id":"%d","name":"book"}}}`, i)),
+		})
+	}
+	_ = conn.WriteJSON(&graphqlWSMessage{ID: message.ID, Type: graphqlWSComplete})
+	_, _, _ = conn.ReadMessage()
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package runner
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -25,27 +26,28 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"google.golang.org/grpc/metadata"
 
 	"github.com/bufbuild/protocompile"
 	"github.com/bufbuild/protocompile/linker"
-	"github.com/jhump/protoreflect/desc"
-	"github.com/jhump/protoreflect/grpcreflect"
 	"github.com/linuxsuren/api-testing/pkg/apispec"
 	"github.com/linuxsuren/api-testing/pkg/compare"
 	"github.com/linuxsuren/api-testing/pkg/logging"
+	"github.com/linuxsuren/api-testing/pkg/render"
 	"github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/linuxsuren/api-testing/pkg/util"
 
 	"github.com/tidwall/gjson"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection/grpc_reflection_v1"
 	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protodesc"
@@ -56,7 +58,7 @@ import (
 )
 
 var (
-	grpcRunnerLogger = logging.DefaultLogger(logging.LogLevelInfo).WithName("memory")
+	grpcRunnerLogger = logging.DefaultLogger(logging.LogLevelInfo).WithNameAndWriter("memory", render.NewMaskWriter(os.Stdout))
 )
 
 type gRPCTestCaseRunner struct {
@@ -64,7 +66,6 @@ type gRPCTestCaseRunner struct {
 	host     string
 	proto    testing.RPCDesc
 	response SimpleResponse
-	// fdCache sync.Map
 }
 
 var regexFullQualifiedName = regexp.MustCompile(`^([\w\.:]+)\/([\w\.]+)\/(\w+)$`)
@@ -95,6 +96,8 @@ func (r *gRPCTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataContext
 	defer func(rr *ReportRecord) {
 		rr.EndTime = time.Now()
 		rr.Error = err
+		rr.Group = testcase.Group
+		rr.Name = testcase.Name
 		rr.API = testcase.Request.API
 		rr.Method = "gRPC"
 		r.testReporter.PutRecord(rr)
@@ -102,7 +105,7 @@ func (r *gRPCTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataContext
 
 	defer func() {
 		if err == nil {
-			err = runJob(testcase.After, dataContext, output)
+			err = runJob(ctx, testcase.After, dataContext, output)
 		}
 	}()
 
@@ -111,20 +114,19 @@ func (r *gRPCTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataContext
 		return
 	}
 
-	if err = runJob(testcase.Before, dataContext, nil); err != nil {
+	if err = runJob(ctx, testcase.Before, dataContext, nil); err != nil {
 		return
 	}
 
 	server := getHost(testcase.Request.API, r.host)
 	r.log.Info("start to send request to %s\n", server)
 
-	var conn *grpc.ClientConn
-	if conn, err = r.getConnection(server); err != nil {
+	var conn grpc.ClientConnInterface
+	if conn, err = r.getConnection(ctx, server, dataContext); err != nil {
 		return
 	}
-	defer conn.Close()
 
-	md, err := getMethodDescriptor(ctx, r, testcase, conn)
+	md, err := getMethodDescriptor(ctx, r, testcase, server, conn)
 	if err != nil {
 		if err == protoregistry.NotFound {
 			return nil, fmt.Errorf("api %q is not found", testcase.Request.API)
@@ -135,11 +137,16 @@ func (r *gRPCTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataContext
 	// pass the headers into gRPC request metadata
 	ctx = metadata.NewOutgoingContext(ctx, metadata.New(testcase.Request.Header))
 
-	payload := testcase.Request.Body
-	respsStr, err := invokeRequest(ctx, md, payload.String(), conn)
+	var resp *grpcResponse
+	if len(testcase.Stream) > 0 {
+		resp, err = r.runStreamSteps(ctx, testcase, dataContext, md, conn)
+	} else {
+		resp, err = invokeRequest(ctx, md, testcase.Request.Body.String(), conn)
+	}
 	if err != nil {
 		return nil, err
 	}
+	respsStr := resp.messages
 
 	if len(respsStr) == 0 {
 		record.Body = strings.Join(respsStr, ",")
@@ -147,62 +154,90 @@ func (r *gRPCTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataContext
 		record.Body = respsStr[0]
 	}
 	r.response.Body = record.Body
+	r.response.Header = resp.headers()
 	r.log.Debug("response body: %s\n", record.Body)
 
-	output, err = verifyResponsePayload(md, testcase.Name, testcase.Expect, respsStr)
+	if err = verifyGRPCCode(testcase.Expect, resp.status); err != nil {
+		return nil, err
+	}
+
+	output, err = verifyResponsePayload(md, testcase.Name, testcase.Expect, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	if output == nil {
+	// there is no message if the call failed with the expected status
+	if output == nil && len(respsStr) > 0 {
 		output, err = NewBodyVerify(util.JSON, nil).Parse([]byte(record.Body))
 	}
+	if err == nil {
+		captureResp, captureBody := resp.captureResponse()
+		err = captureVariables(testcase, captureResp, captureBody, dataContext)
+	}
 	return
 }
 
-func (r *gRPCTestCaseRunner) getConnection(host string) (conn *grpc.ClientConn, err error) {
-	if r.Secure == nil || r.Secure.Insecure {
-		conn, err = grpc.Dial(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	} else {
-		var cred credentials.TransportCredentials
-		cred, err = credentials.NewClientTLSFromFile(r.Secure.CertFile, r.Secure.ServerName)
-		if err == nil {
-			conn, err = grpc.Dial(host, grpc.WithTransportCredentials(cred))
-		}
+// getConnection returns the shared connection of the host, the protocol, the TLS config and the credentials,
+// the credentials are rendered with the data context
+func (r *gRPCTestCaseRunner) getConnection(ctx context.Context, host string, dataContext any) (conn grpc.ClientConnInterface, err error) {
+	desc := r.proto
+	if desc.Credentials, err = renderCredentials(desc.Credentials, dataContext); err != nil {
+		return
 	}
-	return
+	return getRunScope(ctx).grpcConns.get(host, desc, r.Secure)
 }
 
 func (r *gRPCTestCaseRunner) GetSuggestedAPIs(suite *testing.TestSuite, api string) (result []*testing.TestCase, err error) {
+	// the connections and the remote proto files are released after the suggestion
+	ctx, closeScope := WithRunScope(context.Background())
+	defer func() {
+		_ = closeScope()
+	}()
+
 	if suite.Spec.RPC.ServerReflection {
-		var conn *grpc.ClientConn
-		if conn, err = r.getConnection(suite.API); err != nil {
+		var conn grpc.ClientConnInterface
+		if conn, err = r.getConnection(ctx, suite.API, map[string]any{
+			testing.ContextKeyGlobalParam: suite.Param,
+		}); err != nil {
 			return
 		}
 
-		stub := grpc_reflection_v1alpha.NewServerReflectionClient(conn)
-		client := grpcreflect.NewClientV1Alpha(context.Background(), stub)
-
-		var allSvcs []string
-		allSvcs, err = client.ListServices()
-		if err != nil {
+		var resp *grpc_reflection_v1.ServerReflectionResponse
+		if resp, err = reflectServerInfo(ctx, conn, &grpc_reflection_v1.ServerReflectionRequest{
+			MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_ListServices{ListServices: "*"},
+		}); err != nil {
 			return
 		}
-		for _, svc := range allSvcs {
-			var fd *desc.FileDescriptor
-			if fd, err = client.FileContainingSymbol(svc); err != nil {
+
+		for _, svc := range resp.GetListServicesResponse().GetService() {
+			var fdResp *grpc_reflection_v1.ServerReflectionResponse
+			if fdResp, err = reflectServerInfo(ctx, conn, &grpc_reflection_v1.ServerReflectionRequest{
+				MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_FileContainingSymbol{
+					FileContainingSymbol: svc.GetName(),
+				},
+			}); err != nil {
 				return
 			}
-			svcs := fd.GetServices()
-
-			for _, item := range svcs {
-				for _, fdb := range item.GetMethods() {
-					result = append(result, &testing.TestCase{
-						Name: fdb.GetName(),
-						Request: testing.Request{
-							API: fmt.Sprintf("/%s/%s", svc, fdb.GetName()),
-						},
-					})
+
+			for _, fdb := range fdResp.GetFileDescriptorResponse().GetFileDescriptorProto() {
+				fdp := &descriptorpb.FileDescriptorProto{}
+				if err = proto.Unmarshal(fdb, fdp); err != nil {
+					err = fmt.Errorf("failed to unmarshal file descriptor proto: %v", err)
+					return
+				}
+
+				for _, item := range fdp.GetService() {
+					if protoreflect.FullName(fdp.GetPackage()).Append(protoreflect.Name(item.GetName())) != protoreflect.FullName(svc.GetName()) {
+						continue
+					}
+					for _, method := range item.GetMethod() {
+						result = append(result, &testing.TestCase{
+							Name: method.GetName(),
+							Request: testing.Request{
+								API: fmt.Sprintf("/%s/%s", svc.GetName(), method.GetName()),
+							},
+						})
+					}
 				}
 			}
 		}
@@ -210,7 +245,7 @@ func (r *gRPCTestCaseRunner) GetSuggestedAPIs(suite *testing.TestSuite, api stri
 	}
 
 	var linkerFiles linker.Files
-	linkerFiles, err = compileProto(context.Background(), r)
+	linkerFiles, err = compileProto(ctx, r)
 	if err != nil {
 		return
 	}
@@ -245,32 +280,142 @@ func (s *gRPCTestCaseRunner) WithSuite(suite *testing.TestSuite) {
 	// not need this parameter
 }
 
-func invokeRequest(ctx context.Context, md protoreflect.MethodDescriptor, payload string, conn *grpc.ClientConn) (response []string, err error) {
+// grpcResponse is the messages, metadata and status of a gRPC call
+type grpcResponse struct {
+	messages []string
+	header   metadata.MD
+	trailer  metadata.MD
+	// status is nil if the call succeeded
+	status *status.Status
+}
+
+// captureResponse converts the response for capturing the variables. The header has both the header and trailer
+// metadata, the status code is the gRPC code, and the body is the message, or the list of the messages of a stream
+func (r *grpcResponse) captureResponse() (resp *http.Response, body []byte) {
+	resp = &http.Response{
+		Header:     http.Header{},
+		StatusCode: int(r.status.Code()),
+	}
+	for _, md := range []metadata.MD{r.header, r.trailer} {
+		for key, values := range md {
+			for _, val := range values {
+				resp.Header.Add(key, val)
+			}
+		}
+	}
+
+	switch len(r.messages) {
+	case 0:
+	case 1:
+		body = []byte(r.messages[0])
+	default:
+		body = []byte("[" + strings.Join(r.messages, ",") + "]")
+	}
+	return
+}
+
+// invokeRequest sends the request, the error status from the server is kept in the response instead of returning
+func invokeRequest(ctx context.Context, md protoreflect.MethodDescriptor, payload string, conn grpc.ClientConnInterface) (response *grpcResponse, err error) {
+	response = &grpcResponse{}
 	resps := make([]*dynamicpb.Message, 0)
+	var callErr error
 	if md.IsStreamingClient() || md.IsStreamingServer() {
 		reqs, err := getStreamMessagepb(md.Input(), payload)
 		if err != nil {
 			return nil, err
 		}
 
-		resps, err = invokeRPCStream(ctx, conn, md, reqs)
+		resps, callErr = invokeRPCStream(ctx, conn, md, reqs, &response.header, &response.trailer)
+	} else {
+		request, err := getMessagePb(md.Input(), payload)
 		if err != nil {
 			return nil, err
 		}
-		return buildResponses(resps)
+
+		var resp *dynamicpb.Message
+		if resp, callErr = invokeRPC(ctx, conn, md, request, &response.header, &response.trailer); callErr == nil {
+			resps = append(resps, resp)
+		}
 	}
-	request, err := getMessagePb(md.Input(), payload)
-	if err != nil {
-		return nil, err
+
+	var ok bool
+	if response.status, ok = status.FromError(callErr); !ok {
+		return nil, callErr
 	}
+	response.messages, err = buildResponses(resps)
+	return
+}
 
-	resp, err := invokeRPC(ctx, conn, md, request)
-	if err != nil {
-		return nil, err
+// headers merges the header and trailer metadata, the status is included as well
+func (r *grpcResponse) headers() (headers map[string]string) {
+	headers = make(map[string]string, len(r.header)+len(r.trailer)+2)
+	for _, md := range []metadata.MD{r.header, r.trailer} {
+		for k, v := range md {
+			headers[k] = strings.Join(v, ",")
+		}
+	}
+	headers["grpc-status"] = strconv.Itoa(int(r.status.Code()))
+	if message := r.status.Message(); message != "" {
+		headers["grpc-message"] = message
+	}
+	return
+}
+
+// details decodes the status details, the unknown types are kept as the raw bytes
+func (r *grpcResponse) details() (details []map[string]any) {
+	details = []map[string]any{}
+	for _, detail := range r.status.Proto().GetDetails() {
+		item := map[string]any{}
+		if data, err := protojson.Marshal(detail); err != nil || json.Unmarshal(data, &item) != nil {
+			item = map[string]any{
+				"@type": detail.GetTypeUrl(),
+				"value": detail.GetValue(),
+			}
+		}
+		details = append(details, item)
+	}
+	return
+}
+
+// verifyGRPCCode checks the status code, it should be OK if the expected code is not set
+func verifyGRPCCode(expect testing.Response, sta *status.Status) (err error) {
+	if expect.GRPCCode == "" {
+		return sta.Err()
 	}
-	resps = append(resps, resp)
 
-	return buildResponses(resps)
+	var expected codes.Code
+	if expected, err = parseGRPCCode(expect.GRPCCode); err != nil {
+		return
+	}
+	if sta.Code() != expected {
+		err = fmt.Errorf("expect gRPC code %s, but got %s: %s", grpcCodeName(expected), grpcCodeName(sta.Code()), sta.Message())
+	}
+	return
+}
+
+// parseGRPCCode parses the status code from its name or number, such as: NOT_FOUND, 5
+func parseGRPCCode(text string) (code codes.Code, err error) {
+	name := strings.ToUpper(strings.TrimSpace(text))
+	if _, numErr := strconv.Atoi(name); numErr != nil {
+		name = strconv.Quote(name)
+	}
+	if err = code.UnmarshalJSON([]byte(name)); err != nil {
+		err = fmt.Errorf("invalid gRPC code %q", text)
+	}
+	return
+}
+
+// grpcCodeName returns the canonical name of the code, such as: NOT_FOUND
+func grpcCodeName(code codes.Code) string {
+	name := code.String()
+	var buf strings.Builder
+	for i, c := range name {
+		if i > 0 && unicode.IsUpper(c) && unicode.IsLower(rune(name[i-1])) {
+			buf.WriteByte('_')
+		}
+		buf.WriteRune(unicode.ToUpper(c))
+	}
+	return buf.String()
 }
 
 func getStreamMessagepb(md protoreflect.MessageDescriptor, messages string) ([]*dynamicpb.Message, error) {
@@ -319,20 +464,15 @@ func buildResponses(resps []*dynamicpb.Message) ([]string, error) {
 	return respsStr, nil
 }
 
-func getMethodDescriptor(ctx context.Context, r *gRPCTestCaseRunner, testcase *testing.TestCase, conn *grpc.ClientConn) (protoreflect.MethodDescriptor, error) {
+func getMethodDescriptor(ctx context.Context, r *gRPCTestCaseRunner, testcase *testing.TestCase, host string, conn grpc.ClientConnInterface) (protoreflect.MethodDescriptor, error) {
 	fullname, err := splitFullQualifiedName(testcase.Request.API)
 	if err != nil {
 		return nil, err
 	}
 
 	var dp protoreflect.Descriptor
-	// if fd, ok := r.fdCache.Load(fullname.Parent()); ok {
-	// 	grpcRunnerLogger.Info("hit cache",fullname)
-	// 	return getMdFromFd(fd.(protoreflect.FileDescriptor), fullname)
-	// }
-
 	if r.proto.ServerReflection {
-		dp, err = getByReflect(ctx, r, fullname, conn)
+		dp, err = getByReflect(ctx, host, fullname, conn)
 	} else {
 		if r.proto.ProtoFile == "" && r.proto.ProtoSet == "" && r.proto.Raw == "" {
 			return nil, fmt.Errorf("missing descriptor source")
@@ -354,17 +494,37 @@ func getMethodDescriptor(ctx context.Context, r *gRPCTestCaseRunner, testcase *t
 	return nil, protoregistry.NotFound
 }
 
+// compileProto returns the compiled proto files, they are cached until
+// any of the local proto files changes. The downloaded ones are cached in the run scope,
+// so the changes of the remote files are taken in the next run.
 func compileProto(ctx context.Context, r *gRPCTestCaseRunner) (fileLinker linker.Files, err error) {
+	cache := defaultDescriptorCache
+	if regexURLPrefix.MatchString(r.proto.ProtoFile) {
+		cache = getRunScope(ctx).remoteProtos
+	}
+
+	var value any
+	value, err = cache.load(protoSourceKey(r.proto), func() (any, map[string]string, error) {
+		return buildProto(ctx, r.proto)
+	})
+	if err == nil {
+		fileLinker = value.(linker.Files)
+	}
+	return
+}
+
+// buildProto compiles the proto files, and returns the digests of the local files
+func buildProto(ctx context.Context, desc testing.RPCDesc) (fileLinker linker.Files, digests map[string]string, err error) {
 	var protoFile string
 	var importPath []string
 	var parentProtoDir string
-	protoFile, importPath, parentProtoDir, err = util.LoadProtoFiles(r.proto.ProtoFile)
+	protoFile, importPath, parentProtoDir, err = util.LoadProtoFiles(desc.ProtoFile)
 	if err != nil {
 		return
 	}
 
 	if len(importPath) == 0 {
-		importPath = r.proto.ImportPath
+		importPath = append(importPath, desc.ImportPath...)
 	}
 
 	if parentProtoDir != "" {
@@ -381,6 +541,9 @@ func compileProto(ctx context.Context, r *gRPCTestCaseRunner) (fileLinker linker
 		return
 	}
 
+	// the raw content is a part of the cache key already
+	var rawProtoFile string
+	digests = map[string]string{}
 	grpcRunnerLogger.Info("proto import files", "files", importPath)
 	compiler := protocompile.Compiler{
 		Resolver: protocompile.WithStandardImports(
@@ -390,14 +553,22 @@ func compileProto(ctx context.Context, r *gRPCTestCaseRunner) (fileLinker linker
 					if content, ok := protoLibrary[strings.TrimPrefix(path, parentProtoDir+"/")]; ok {
 						return io.NopCloser(strings.NewReader(content)), nil
 					}
-					return os.Open(path)
+
+					data, err := os.ReadFile(path)
+					if err != nil {
+						return nil, err
+					}
+					if path != rawProtoFile {
+						digests[path] = contentDigest(data)
+					}
+					return io.NopCloser(bytes.NewReader(data)), nil
 				},
 			},
 		),
 	}
 
 	// save the proto to a temp file if the raw content given
-	if r.proto.Raw != "" {
+	if desc.Raw != "" {
 		var f *os.File
 		f, err = os.CreateTemp(os.TempDir(), "proto")
 		if err != nil {
@@ -406,15 +577,18 @@ func compileProto(ctx context.Context, r *gRPCTestCaseRunner) (fileLinker linker
 		}
 		defer os.Remove(f.Name())
 
-		_, err = f.WriteString(r.proto.Raw)
+		_, err = f.WriteString(desc.Raw)
+		_ = f.Close()
 		if err != nil {
 			err = fmt.Errorf("failed to write proto content to file %q: %v", f.Name(), err)
 			return
 		}
 		protoFile = f.Name()
+		rawProtoFile = protoFile
 	}
 
-	return compiler.Compile(ctx, protoFile)
+	fileLinker, err = compiler.Compile(ctx, protoFile)
+	return
 }
 
 func getByProto(ctx context.Context, r *gRPCTestCaseRunner, fullName protoreflect.FullName) (protoreflect.Descriptor, error) {
@@ -428,83 +602,113 @@ func getByProto(ctx context.Context, r *gRPCTestCaseRunner, fullName protoreflec
 		return nil, err
 	}
 
-	dp, err := linker.AsResolver().FindDescriptorByName(fullName)
+	return linker.AsResolver().FindDescriptorByName(fullName)
+}
+
+func getByProtoSet(ctx context.Context, r *gRPCTestCaseRunner, fullName protoreflect.FullName) (protoreflect.Descriptor, error) {
+	prfs, err := loadProtoSet(r.proto.ProtoSet)
 	if err != nil {
 		return nil, err
 	}
 
-	// r.fdCache.Store(fullName.Parent(), dp.ParentFile())
-	return dp, nil
+	return prfs.FindDescriptorByName(fullName)
 }
 
-func getByProtoSet(ctx context.Context, r *gRPCTestCaseRunner, fullName protoreflect.FullName) (protoreflect.Descriptor, error) {
+// loadProtoSet loads the protoset from a local file or an URL, the parsed
+// descriptors are cached by the source and the content hash
+func loadProtoSet(protoSet string) (files *protoregistry.Files, err error) {
 	var decs []byte
-	var err error
-	if regexURLPrefix.FindString(r.proto.ProtoSet) != "" {
-		resp, err := http.Get(r.proto.ProtoSet)
-		if err != nil {
-			return nil, err
-		}
-		defer resp.Body.Close()
+	if decs, err = readProtoSet(protoSet); err != nil {
+		return
+	}
 
-		decs, err = io.ReadAll(resp.Body)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		decs, err = os.ReadFile(r.proto.ProtoSet)
-		if err != nil {
-			return nil, err
+	var value any
+	key := fmt.Sprintf("protoset:%s:%s", protoSet, contentDigest(decs))
+	value, err = defaultDescriptorCache.load(key, func() (any, map[string]string, error) {
+		fds := &descriptorpb.FileDescriptorSet{}
+		if err := proto.Unmarshal(decs, fds); err != nil {
+			return nil, nil, err
 		}
+		files, err := protodesc.NewFiles(fds)
+		return files, nil, err
+	})
+	if err == nil {
+		files = value.(*protoregistry.Files)
+	}
+	return
+}
+
+func readProtoSet(protoSet string) (decs []byte, err error) {
+	if regexURLPrefix.FindString(protoSet) == "" {
+		return os.ReadFile(protoSet)
 	}
 
-	fds := &descriptorpb.FileDescriptorSet{}
-	err = proto.Unmarshal(decs, fds)
-	if err != nil {
-		return nil, err
+	var resp *http.Response
+	if resp, err = http.Get(protoSet); err != nil {
+		return
 	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
 
-	prfs, err := protodesc.NewFiles(fds)
-	if err != nil {
-		return nil, err
+// LoadProtoDescriptors loads all the file descriptors from the proto file, or the protoset
+func LoadProtoDescriptors(ctx context.Context, desc testing.RPCDesc) (files *protoregistry.Files, err error) {
+	if desc.ProtoSet != "" {
+		return loadProtoSet(desc.ProtoSet)
 	}
 
-	dp, err := prfs.FindDescriptorByName(fullName)
-	if err != nil {
-		return nil, err
+	var fileLinker linker.Files
+	if fileLinker, err = compileProto(ctx, &gRPCTestCaseRunner{proto: desc}); err != nil {
+		err = fmt.Errorf("failed to compile proto: %v", err)
+		return
 	}
 
-	// r.fdCache.Store(fullName.Parent(), dp.ParentFile())
-	return dp, nil
+	files = &protoregistry.Files{}
+	for _, fd := range fileLinker {
+		if err = registerProtoFile(files, fd); err != nil {
+			return
+		}
+	}
+	return
 }
 
-func getByReflect(ctx context.Context, r *gRPCTestCaseRunner, fullName protoreflect.FullName, conn *grpc.ClientConn) (md protoreflect.Descriptor, err error) {
-	reflectconn := grpc_reflection_v1.NewServerReflectionClient(conn)
-	cli, err := reflectconn.ServerReflectionInfo(ctx)
-	if err != nil {
-		return nil, err
+// registerProtoFile registers the file descriptor together with its imports
+func registerProtoFile(files *protoregistry.Files, fd protoreflect.FileDescriptor) (err error) {
+	if _, findErr := files.FindFileByPath(fd.Path()); findErr == nil {
+		return
 	}
-	req := &grpc_reflection_v1.ServerReflectionRequest{
-		Host: "",
-		MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_FileContainingSymbol{
-			FileContainingSymbol: string(fullName),
-		},
+
+	imports := fd.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		if err = registerProtoFile(files, imports.Get(i).FileDescriptor); err != nil {
+			return
+		}
 	}
+	return files.RegisterFile(fd)
+}
 
-	err = cli.Send(req)
-	if err != nil {
-		return nil, err
+// getByReflect finds the descriptor through the server reflection, it is cached by the target and the symbol in the run scope
+func getByReflect(ctx context.Context, host string, fullName protoreflect.FullName, conn grpc.ClientConnInterface) (md protoreflect.Descriptor, err error) {
+	key := fmt.Sprintf("reflect:%s:%s", host, fullName)
+	var value any
+	if value, err = getRunScope(ctx).reflection.load(key, func() (any, map[string]string, error) {
+		md, err := findByReflect(ctx, fullName, conn)
+		return md, nil, err
+	}); err == nil {
+		md = value.(protoreflect.Descriptor)
 	}
+	return
+}
 
-	resp, err := cli.Recv()
+func findByReflect(ctx context.Context, fullName protoreflect.FullName, conn grpc.ClientConnInterface) (md protoreflect.Descriptor, err error) {
+	resp, err := reflectServerInfo(ctx, conn, &grpc_reflection_v1.ServerReflectionRequest{
+		MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_FileContainingSymbol{
+			FileContainingSymbol: string(fullName),
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
-	_ = cli.CloseSend()
-
-	if resp := resp.GetErrorResponse(); resp != nil {
-		return nil, fmt.Errorf("%s", resp.GetErrorMessage())
-	}
 
 	fdresp := resp.GetFileDescriptorResponse()
 
@@ -522,7 +726,6 @@ func getByReflect(ctx context.Context, r *gRPCTestCaseRunner, fullName protorefl
 
 		md, err = getMdFromFd(fd, fullName)
 		if err == nil {
-			// r.fdCache.Store(fullName.Parent(), fd)
 			return md, nil
 		}
 	}
@@ -530,6 +733,75 @@ func getByReflect(ctx context.Context, r *gRPCTestCaseRunner, fullName protorefl
 	return nil, protoregistry.NotFound
 }
 
+// reflectServerInfo sends one request through a new reflection stream,
+// the sending is closed before receiving so that it works with the half-duplex protocols.
+// The v1alpha reflection is used if the server does not implement the v1 one.
+func reflectServerInfo(ctx context.Context, conn grpc.ClientConnInterface,
+	req *grpc_reflection_v1.ServerReflectionRequest) (resp *grpc_reflection_v1.ServerReflectionResponse, err error) {
+	if resp, err = reflectServerInfoV1(ctx, conn, req); status.Code(err) == codes.Unimplemented {
+		grpcRunnerLogger.Info("the reflection v1 is not implemented, try v1alpha")
+		resp, err = reflectServerInfoV1Alpha(ctx, conn, req)
+	}
+	if err != nil {
+		return
+	}
+	if errResp := resp.GetErrorResponse(); errResp != nil {
+		err = fmt.Errorf("%s", errResp.GetErrorMessage())
+	}
+	return
+}
+
+func reflectServerInfoV1(ctx context.Context, conn grpc.ClientConnInterface,
+	req *grpc_reflection_v1.ServerReflectionRequest) (resp *grpc_reflection_v1.ServerReflectionResponse, err error) {
+	var cli grpc_reflection_v1.ServerReflection_ServerReflectionInfoClient
+	if cli, err = grpc_reflection_v1.NewServerReflectionClient(conn).ServerReflectionInfo(ctx); err != nil {
+		return
+	}
+	if err = cli.Send(req); err != nil {
+		return
+	}
+	if err = cli.CloseSend(); err != nil {
+		return
+	}
+	resp, err = cli.Recv()
+	return
+}
+
+// reflectServerInfoV1Alpha converts the messages through the wire format, they are the same in v1 and v1alpha
+func reflectServerInfoV1Alpha(ctx context.Context, conn grpc.ClientConnInterface,
+	req *grpc_reflection_v1.ServerReflectionRequest) (resp *grpc_reflection_v1.ServerReflectionResponse, err error) {
+	alphaReq := &grpc_reflection_v1alpha.ServerReflectionRequest{}
+	if err = convertMessage(req, alphaReq); err != nil {
+		return
+	}
+
+	var cli grpc_reflection_v1alpha.ServerReflection_ServerReflectionInfoClient
+	if cli, err = grpc_reflection_v1alpha.NewServerReflectionClient(conn).ServerReflectionInfo(ctx); err != nil {
+		return
+	}
+	if err = cli.Send(alphaReq); err != nil {
+		return
+	}
+	if err = cli.CloseSend(); err != nil {
+		return
+	}
+
+	var alphaResp *grpc_reflection_v1alpha.ServerReflectionResponse
+	if alphaResp, err = cli.Recv(); err == nil {
+		resp = &grpc_reflection_v1.ServerReflectionResponse{}
+		err = convertMessage(alphaResp, resp)
+	}
+	return
+}
+
+func convertMessage(from, to proto.Message) (err error) {
+	var data []byte
+	if data, err = proto.Marshal(from); err == nil {
+		err = proto.Unmarshal(data, to)
+	}
+	return
+}
+
 func getMdFromFd(fd protoreflect.FileDescriptor, fullname protoreflect.FullName) (md protoreflect.MethodDescriptor, err error) {
 	sd := fd.Services().ByName(fullname.Parent().Name())
 	if sd == nil {
@@ -568,22 +840,22 @@ func getMethodName(md protoreflect.MethodDescriptor) string {
 }
 
 // invokeRPC sends a unary RPC to gRPC server.
-func invokeRPC(ctx context.Context, conn grpc.ClientConnInterface, method protoreflect.MethodDescriptor, request *dynamicpb.Message) (
-	resp *dynamicpb.Message, err error) {
+func invokeRPC(ctx context.Context, conn grpc.ClientConnInterface, method protoreflect.MethodDescriptor, request *dynamicpb.Message,
+	header, trailer *metadata.MD) (resp *dynamicpb.Message, err error) {
 	resp = dynamicpb.NewMessage(method.Output())
-	md, _ := metadata.FromIncomingContext(ctx)
-	err = conn.Invoke(ctx, getMethodName(method), request, resp, grpc.Header(&md))
+	err = conn.Invoke(ctx, getMethodName(method), request, resp, grpc.Header(header), grpc.Trailer(trailer))
 	return
 }
 
 // invokeRPCStream combine all three types of streaming rpc into a single function.
-func invokeRPCStream(ctx context.Context, conn grpc.ClientConnInterface, method protoreflect.MethodDescriptor, requests []*dynamicpb.Message) (resps []*dynamicpb.Message, err error) {
+func invokeRPCStream(ctx context.Context, conn grpc.ClientConnInterface, method protoreflect.MethodDescriptor, requests []*dynamicpb.Message,
+	header, trailer *metadata.MD) (resps []*dynamicpb.Message, err error) {
 	sd := &grpc.StreamDesc{
 		StreamName:    string(method.Name()),
 		ServerStreams: method.IsStreamingServer(),
 		ClientStreams: method.IsStreamingClient(),
 	}
-	s, err := conn.NewStream(ctx, sd, getMethodName(method))
+	s, err := conn.NewStream(ctx, sd, getMethodName(method), grpc.Header(header), grpc.Trailer(trailer))
 	if err != nil {
 		return nil, err
 	}
@@ -627,8 +899,14 @@ sendLoop:
 	}
 }
 
-func verifyResponsePayload(md protoreflect.MethodDescriptor, caseName string, expect testing.Response, jsonPayload []string) (output any, err error) {
+func verifyResponsePayload(md protoreflect.MethodDescriptor, caseName string, expect testing.Response, resp *grpcResponse) (output any, err error) {
+	jsonPayload := resp.messages
 	mapOutput := map[string]any{
+		"code":    grpcCodeName(resp.status.Code()),
+		"message": resp.status.Message(),
+		"details": resp.details(),
+		"header":  metadataToMap(resp.header),
+		"trailer": metadataToMap(resp.trailer),
 		"data": func() []map[string]any {
 			r := make([]map[string]any, len(jsonPayload))
 			for i := range jsonPayload {
@@ -651,6 +929,14 @@ func verifyResponsePayload(md protoreflect.MethodDescriptor, caseName string, ex
 	return
 }
 
+func metadataToMap(md metadata.MD) (result map[string]any) {
+	result = make(map[string]any, len(md))
+	for k, v := range md {
+		result[k] = strings.Join(v, ",")
+	}
+	return
+}
+
 func payloadFieldsVerify(md protoreflect.MethodDescriptor, caseName string, expect testing.Response, jsonPayload []string) error {
 	if expect.Body == "" {
 		return nil
@@ -0,0 +1,245 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// descriptorCache keeps the compiled proto descriptors, so that the cases
+// of the same suite do not compile or parse the same source again.
+// An entry is reused only when all the local files it was built from
+// still have the same content.
+type descriptorCache struct {
+	mu      sync.RWMutex
+	entries map[string]*descriptorCacheEntry
+	group   singleflight.Group
+}
+
+type descriptorCacheEntry struct {
+	value any
+	// digests are the content hashes of the local files, keyed by the path
+	digests map[string]string
+}
+
+// descriptorLoader builds a cache value, and returns the files it depends on
+type descriptorLoader func() (value any, digests map[string]string, err error)
+
+var defaultDescriptorCache = newDescriptorCache()
+
+func newDescriptorCache() *descriptorCache {
+	return &descriptorCache{
+		entries: map[string]*descriptorCacheEntry{},
+	}
+}
+
+// load returns the cached value of the key, or builds it with the loader.
+// The concurrent loads of the same key share one build.
+func (c *descriptorCache) load(key string, loader descriptorLoader) (value any, err error) {
+	if value, ok := c.get(key); ok {
+		return value, nil
+	}
+
+	value, err, _ = c.group.Do(key, func() (any, error) {
+		if value, ok := c.get(key); ok {
+			return value, nil
+		}
+
+		value, digests, err := loader()
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		c.entries[key] = &descriptorCacheEntry{value: value, digests: digests}
+		c.mu.Unlock()
+		return value, nil
+	})
+	return
+}
+
+func (c *descriptorCache) get(key string) (value any, ok bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+	if !ok {
+		return
+	}
+
+	for path, digest := range entry.digests {
+		if current, err := fileDigest(path); err != nil || current != digest {
+			grpcRunnerLogger.Info("proto descriptor is outdated", "key", key, "file", path)
+			c.mu.Lock()
+			if c.entries[key] == entry {
+				delete(c.entries, key)
+			}
+			c.mu.Unlock()
+			return nil, false
+		}
+	}
+	return entry.value, true
+}
+
+func (c *descriptorCache) reset() {
+	c.mu.Lock()
+	c.entries = map[string]*descriptorCacheEntry{}
+	c.mu.Unlock()
+}
+
+// protoSourceKey identifies the proto source of a RPC description
+func protoSourceKey(desc testing.RPCDesc) string {
+	if desc.Raw != "" {
+		return fmt.Sprintf("raw:%s:%s", contentDigest([]byte(desc.Raw)), strings.Join(desc.ImportPath, ","))
+	}
+	return fmt.Sprintf("file:%s:%s", desc.ProtoFile, strings.Join(desc.ImportPath, ","))
+}
+
+func contentDigest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func fileDigest(path string) (digest string, err error) {
+	var data []byte
+	if data, err = os.ReadFile(path); err == nil {
+		digest = contentDigest(data)
+	}
+	return
+}
+
+// grpcConnectionPool shares the client connections of the same target, protocol,
+// TLS config and credentials. The connections of a run scope are closed once the run
+// is finished, the default ones are closed by CloseGRPCConnections.
+type grpcConnectionPool struct {
+	mu    sync.Mutex
+	conns map[grpcConnectionKey]grpc.ClientConnInterface
+}
+
+type grpcConnectionKey struct {
+	host        string
+	protocol    string
+	secure      testing.Secure
+	credentials string
+}
+
+var defaultGRPCConnectionPool = newGRPCConnectionPool()
+
+func newGRPCConnectionPool() *grpcConnectionPool {
+	return &grpcConnectionPool{
+		conns: map[grpcConnectionKey]grpc.ClientConnInterface{},
+	}
+}
+
+func (p *grpcConnectionPool) get(host string, desc testing.RPCDesc, secure *testing.Secure) (conn grpc.ClientConnInterface, err error) {
+	protocol := desc.Protocol
+	if protocol == "" {
+		protocol = grpcProtocolNative
+	}
+	key := grpcConnectionKey{host: host, protocol: protocol}
+	if secure != nil {
+		key.secure = *secure
+	}
+	if len(desc.Credentials) > 0 {
+		var data []byte
+		if data, err = json.Marshal(desc.Credentials); err != nil {
+			return
+		}
+		key.credentials = string(data)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if conn = p.conns[key]; conn != nil {
+		if clientConn, ok := conn.(*grpc.ClientConn); !ok || clientConn.GetState() != connectivity.Shutdown {
+			return
+		}
+	}
+
+	var perRPC []credentials.PerRPCCredentials
+	if perRPC, err = newPerRPCCredentials(desc.Credentials); err != nil {
+		return
+	}
+
+	switch protocol {
+	case grpcProtocolNative:
+		conn, err = dialGRPC(host, secure, perRPC)
+	case grpcProtocolWeb, grpcProtocolConnect:
+		conn, err = newHTTPRPCConn(protocol, host, secure, perRPC)
+	default:
+		err = fmt.Errorf("unsupported gRPC protocol %q, it should be one of %s, %s and %s",
+			protocol, grpcProtocolNative, grpcProtocolWeb, grpcProtocolConnect)
+	}
+	if err == nil {
+		p.conns[key] = conn
+	}
+	return
+}
+
+func (p *grpcConnectionPool) close() (err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var errs []error
+	for key, conn := range p.conns {
+		closer, ok := conn.(io.Closer)
+		if !ok {
+			continue
+		}
+		if closeErr := closer.Close(); closeErr != nil {
+			errs = append(errs, fmt.Errorf("failed to close connection of %q: %v", key.host, closeErr))
+		}
+		delete(p.conns, key)
+	}
+	return errors.Join(errs...)
+}
+
+func dialGRPC(host string, secure *testing.Secure, perRPC []credentials.PerRPCCredentials) (conn *grpc.ClientConn, err error) {
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if secure != nil && !secure.Insecure {
+		var config *tls.Config
+		if config, err = buildTLSConfig(secure); err != nil {
+			return
+		}
+		opts[0] = grpc.WithTransportCredentials(credentials.NewTLS(config))
+	}
+	for _, cred := range perRPC {
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	conn, err = grpc.Dial(host, opts...)
+	return
+}
+
+// CloseGRPCConnections closes all the shared gRPC connections which are not in a run scope
+func CloseGRPCConnections() error {
+	return defaultRunScope.closeGRPC()
+}
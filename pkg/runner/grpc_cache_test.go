@@ -0,0 +1,215 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/h2non/gock"
+	testsrv "github.com/linuxsuren/api-testing/pkg/runner/grpc_test"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+func TestDescriptorCache(t *testing.T) {
+	t.Run("proto file", func(t *testing.T) {
+		protoFile := filepath.Join(t.TempDir(), "test.proto")
+		assert.NoError(t, os.WriteFile(protoFile, []byte(sampleProto), 0644))
+		r := &gRPCTestCaseRunner{proto: atest.RPCDesc{ProtoFile: protoFile}}
+
+		first, err := compileProto(context.TODO(), r)
+		assert.NoError(t, err)
+		second, err := compileProto(context.TODO(), r)
+		assert.NoError(t, err)
+		assert.Same(t, first[0], second[0])
+
+		// compile it again once the content changed
+		changed := strings.Replace(sampleProto, "message Empty {", "message Changed {}\n\nmessage Empty {", 1)
+		assert.NoError(t, os.WriteFile(protoFile, []byte(changed), 0644))
+		third, err := compileProto(context.TODO(), r)
+		assert.NoError(t, err)
+		assert.NotSame(t, first[0], third[0])
+		assert.NotNil(t, third[0].Messages().ByName("Changed"))
+	})
+
+	t.Run("raw", func(t *testing.T) {
+		r := &gRPCTestCaseRunner{proto: atest.RPCDesc{Raw: sampleProto}}
+		first, err := compileProto(context.TODO(), r)
+		assert.NoError(t, err)
+		second, err := compileProto(context.TODO(), r)
+		assert.NoError(t, err)
+		assert.Same(t, first[0], second[0])
+	})
+
+	t.Run("remote proto file", func(t *testing.T) {
+		defer gock.Off()
+		gock.New("http://localhost").Get("/remote.proto").Times(2).Reply(http.StatusOK).BodyString(sampleProto)
+		r := &gRPCTestCaseRunner{proto: atest.RPCDesc{ProtoFile: "http://localhost/remote.proto"}}
+
+		ctx, closeScope := WithRunScope(context.TODO())
+		first, err := compileProto(ctx, r)
+		assert.NoError(t, err)
+		second, err := compileProto(ctx, r)
+		assert.NoError(t, err)
+		assert.Same(t, first[0], second[0])
+		assert.NoError(t, closeScope())
+
+		// download it again in the next run
+		ctx, closeScope = WithRunScope(context.TODO())
+		defer func() {
+			_ = closeScope()
+		}()
+		third, err := compileProto(ctx, r)
+		assert.NoError(t, err)
+		assert.NotSame(t, first[0], third[0])
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("protoset", func(t *testing.T) {
+		protoSet := filepath.Join(t.TempDir(), "test.pb")
+		data, err := os.ReadFile("grpc_test/test.pb")
+		assert.NoError(t, err)
+		assert.NoError(t, os.WriteFile(protoSet, data, 0644))
+
+		first, err := loadProtoSet(protoSet)
+		assert.NoError(t, err)
+		second, err := loadProtoSet(protoSet)
+		assert.NoError(t, err)
+		assert.Same(t, first, second)
+
+		assert.NoError(t, os.WriteFile(protoSet, []byte("invalid"), 0644))
+		_, err = loadProtoSet(protoSet)
+		assert.Error(t, err)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := compileProto(context.TODO(), &gRPCTestCaseRunner{proto: atest.RPCDesc{ProtoFile: "fake.proto"}})
+		assert.Error(t, err)
+	})
+}
+
+func TestGRPCConnectionPool(t *testing.T) {
+	pool := &grpcConnectionPool{conns: map[grpcConnectionKey]grpc.ClientConnInterface{}}
+
+	first, err := pool.get("localhost:7070", atest.RPCDesc{}, nil)
+	assert.NoError(t, err)
+	second, err := pool.get("localhost:7070", atest.RPCDesc{Protocol: grpcProtocolNative}, &atest.Secure{})
+	assert.NoError(t, err)
+	assert.Same(t, first, second)
+
+	other, err := pool.get("localhost:7070", atest.RPCDesc{}, &atest.Secure{Insecure: true})
+	assert.NoError(t, err)
+	assert.NotSame(t, first, other)
+
+	web, err := pool.get("localhost:7070", atest.RPCDesc{Protocol: grpcProtocolWeb}, nil)
+	assert.NoError(t, err)
+	assert.IsType(t, &httpRPCConn{}, web)
+
+	_, err = pool.get("localhost:7070", atest.RPCDesc{}, &atest.Secure{CertFile: "fake"})
+	assert.Error(t, err)
+
+	_, err = pool.get("localhost:7070", atest.RPCDesc{Protocol: "fake"}, nil)
+	assert.Error(t, err)
+
+	withToken, err := pool.get("localhost:7070", atest.RPCDesc{Credentials: []atest.RPCCredential{{
+		Type: "bearer", Token: "token",
+	}}}, nil)
+	assert.NoError(t, err)
+	assert.NotSame(t, first, withToken)
+
+	_, err = pool.get("localhost:7070", atest.RPCDesc{Credentials: []atest.RPCCredential{{Type: "fake"}}}, nil)
+	assert.Error(t, err)
+
+	// dial again once the connection is closed
+	assert.NoError(t, first.(*grpc.ClientConn).Close())
+	third, err := pool.get("localhost:7070", atest.RPCDesc{}, nil)
+	assert.NoError(t, err)
+	assert.NotSame(t, first, third)
+
+	assert.NoError(t, pool.close())
+	assert.Empty(t, pool.conns)
+	assert.Equal(t, connectivity.Shutdown, third.(*grpc.ClientConn).GetState())
+}
+
+func BenchmarkCompileProto(b *testing.B) {
+	desc := atest.RPCDesc{
+		ImportPath: []string{"grpc_test"},
+		ProtoFile:  "test.proto",
+	}
+
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, _, err := buildProto(context.TODO(), desc); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("cached", func(b *testing.B) {
+		r := &gRPCTestCaseRunner{proto: desc}
+		for i := 0; i < b.N; i++ {
+			if _, err := compileProto(context.TODO(), r); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkGRPCRunTestCase(b *testing.B) {
+	s := grpc.NewServer()
+	testsrv.RegisterMainServer(s, &testsrv.TestServer{})
+	l := runServer(b, s)
+	defer s.Stop()
+
+	runner := NewGRPCTestCaseRunner(l.Addr().String(), atest.RPCDesc{
+		ImportPath: []string{"grpc_test"},
+		ProtoFile:  "test.proto",
+	})
+	testcase := &atest.TestCase{
+		Request: atest.Request{
+			API:  l.Addr().String() + unary,
+			Body: atest.NewRequestBody("{}"),
+		},
+	}
+
+	b.Run("shared", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := runner.RunTestCase(testcase, nil, context.TODO()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("fresh", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			defaultDescriptorCache.reset()
+			if err := CloseGRPCConnections(); err != nil {
+				b.Fatal(err)
+			}
+			if _, err := runner.RunTestCase(testcase, nil, context.TODO()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/render"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"google.golang.org/grpc/credentials"
+)
+
+// the types of the per-RPC credentials
+const (
+	rpcCredentialBearer   = "bearer"
+	rpcCredentialMetadata = "metadata"
+	rpcCredentialOAuth2   = "oauth2"
+	rpcCredentialJWT      = "jwt"
+)
+
+const defaultJWTTTL = time.Hour
+
+// buildTLSConfig builds the client TLS config with the CA pool, the client certificate,
+// the server name and the minimum version
+func buildTLSConfig(secure *testing.Secure) (config *tls.Config, err error) {
+	config = &tls.Config{ServerName: secure.ServerName}
+	if config.MinVersion, err = parseTLSVersion(secure.MinVersion); err != nil {
+		return
+	}
+
+	caFile := secure.CAFile
+	if caFile == "" && secure.KeyFile == "" {
+		// the cert is taken as the CA when there is no client key
+		caFile = secure.CertFile
+	}
+	if caFile != "" {
+		var data []byte
+		if data, err = os.ReadFile(caFile); err != nil {
+			err = fmt.Errorf("failed to read the CA file: %v", err)
+			return
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(data) {
+			err = fmt.Errorf("failed to append certificates from %q", caFile)
+			return
+		}
+	}
+
+	if secure.KeyFile != "" {
+		var cert tls.Certificate
+		if cert, err = tls.LoadX509KeyPair(secure.CertFile, secure.KeyFile); err != nil {
+			err = fmt.Errorf("failed to load the client certificate: %v", err)
+			return
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return
+}
+
+func parseTLSVersion(version string) (result uint16, err error) {
+	switch strings.TrimPrefix(strings.ToLower(version), "tls") {
+	case "":
+	case "1.0":
+		result = tls.VersionTLS10
+	case "1.1":
+		result = tls.VersionTLS11
+	case "1.2":
+		result = tls.VersionTLS12
+	case "1.3":
+		result = tls.VersionTLS13
+	default:
+		err = fmt.Errorf("invalid TLS version %q, it should be one of 1.0, 1.1, 1.2 and 1.3", version)
+	}
+	return
+}
+
+// renderCredentials renders the tokens, the secrets and the metadata of the credentials,
+// the rendered values are masked in the outputs
+func renderCredentials(items []testing.RPCCredential, dataContext any) (result []testing.RPCCredential, err error) {
+	for i, item := range items {
+		for name, field := range map[string]*string{
+			"token":        &item.Token,
+			"clientSecret": &item.ClientSecret,
+			"secret":       &item.Secret,
+		} {
+			if *field, err = renderCredentialValue(*field, dataContext); err != nil {
+				err = fmt.Errorf("invalid credential %d: failed to render the %s: %v", i+1, name, err)
+				return
+			}
+		}
+
+		if item.Metadata != nil {
+			metadata := make(map[string]string, len(item.Metadata))
+			for key, value := range item.Metadata {
+				if metadata[key], err = renderCredentialValue(value, dataContext); err != nil {
+					err = fmt.Errorf("invalid credential %d: failed to render the metadata %q: %v", i+1, key, err)
+					return
+				}
+			}
+			item.Metadata = metadata
+		}
+		result = append(result, item)
+	}
+	return
+}
+
+func renderCredentialValue(text string, dataContext any) (result string, err error) {
+	if result, err = render.Render("credential", text, dataContext); err == nil {
+		render.AddSecretValue(result)
+	}
+	return
+}
+
+// newPerRPCCredentials creates the credentials which are attached to every call
+func newPerRPCCredentials(items []testing.RPCCredential) (result []credentials.PerRPCCredentials, err error) {
+	for i, item := range items {
+		var cred credentials.PerRPCCredentials
+		if cred, err = newPerRPCCredential(item); err != nil {
+			err = fmt.Errorf("invalid credential %d: %v", i+1, err)
+			return
+		}
+		result = append(result, cred)
+	}
+	return
+}
+
+func newPerRPCCredential(item testing.RPCCredential) (cred credentials.PerRPCCredentials, err error) {
+	switch item.Type {
+	case rpcCredentialBearer:
+		if item.Token == "" {
+			err = errors.New("token is required")
+			return
+		}
+		cred = &tokenCredential{source: oauth2.StaticTokenSource(&oauth2.Token{
+			AccessToken: item.Token,
+			TokenType:   "Bearer",
+		})}
+	case rpcCredentialMetadata:
+		if len(item.Metadata) == 0 {
+			err = errors.New("metadata is required")
+			return
+		}
+		cred = metadataCredential(item.Metadata)
+	case rpcCredentialOAuth2:
+		if item.TokenURL == "" || item.ClientID == "" {
+			err = errors.New("tokenURL and clientID are required")
+			return
+		}
+		config := &clientcredentials.Config{
+			ClientID:     item.ClientID,
+			ClientSecret: item.ClientSecret,
+			TokenURL:     item.TokenURL,
+			Scopes:       item.Scopes,
+		}
+		cred = &tokenCredential{source: config.TokenSource(context.Background())}
+	case rpcCredentialJWT:
+		var source *jwtTokenSource
+		if source, err = newJWTTokenSource(item); err == nil {
+			cred = &tokenCredential{source: oauth2.ReuseTokenSource(nil, source)}
+		}
+	default:
+		err = fmt.Errorf("unsupported credential type %q, it should be one of %s, %s, %s and %s",
+			item.Type, rpcCredentialBearer, rpcCredentialMetadata, rpcCredentialOAuth2, rpcCredentialJWT)
+	}
+	return
+}
+
+// tokenCredential sends the token as the authorization metadata,
+// the token source takes care of the refreshing
+type tokenCredential struct {
+	source oauth2.TokenSource
+}
+
+func (c *tokenCredential) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token, err := c.source.Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the token: %v", err)
+	}
+	return map[string]string{
+		"authorization": token.Type() + " " + token.AccessToken,
+	}, nil
+}
+
+// RequireTransportSecurity allows sending the token over the plaintext connection,
+// it is common for the testing environment
+func (c *tokenCredential) RequireTransportSecurity() bool {
+	return false
+}
+
+// metadataCredential sends the static metadata, such as an API key
+type metadataCredential map[string]string
+
+func (c metadataCredential) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return c, nil
+}
+
+func (c metadataCredential) RequireTransportSecurity() bool {
+	return false
+}
+
+// jwtTokenSource signs a new JWT with the claims every time
+type jwtTokenSource struct {
+	secret []byte
+	key    *rsa.PrivateKey
+	claims map[string]string
+	ttl    time.Duration
+}
+
+func newJWTTokenSource(item testing.RPCCredential) (source *jwtTokenSource, err error) {
+	source = &jwtTokenSource{
+		secret: []byte(item.Secret),
+		claims: item.Claims,
+		ttl:    defaultJWTTTL,
+	}
+	if item.TTL != "" {
+		if source.ttl, err = time.ParseDuration(item.TTL); err != nil {
+			err = fmt.Errorf("invalid ttl: %v", err)
+			return
+		}
+	}
+
+	switch {
+	case item.KeyFile != "":
+		source.key, err = loadRSAPrivateKey(item.KeyFile)
+	case item.Secret == "":
+		err = errors.New("secret or key is required")
+	}
+	return
+}
+
+func (s *jwtTokenSource) Token() (token *oauth2.Token, err error) {
+	now := time.Now()
+	claims := map[string]any{}
+	for key, value := range s.claims {
+		claims[key] = value
+	}
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(s.ttl).Unix()
+
+	algorithm := "HS256"
+	if s.key != nil {
+		algorithm = "RS256"
+	}
+
+	var header, payload []byte
+	if header, err = json.Marshal(map[string]string{"alg": algorithm, "typ": "JWT"}); err != nil {
+		return
+	}
+	if payload, err = json.Marshal(claims); err != nil {
+		return
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	var signature []byte
+	if s.key != nil {
+		digest := sha256.Sum256([]byte(signingInput))
+		if signature, err = rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, digest[:]); err != nil {
+			return
+		}
+	} else {
+		mac := hmac.New(sha256.New, s.secret)
+		mac.Write([]byte(signingInput))
+		signature = mac.Sum(nil)
+	}
+
+	token = &oauth2.Token{
+		AccessToken: signingInput + "." + base64.RawURLEncoding.EncodeToString(signature),
+		TokenType:   "Bearer",
+		Expiry:      now.Add(s.ttl),
+	}
+	return
+}
+
+func loadRSAPrivateKey(keyFile string) (key *rsa.PrivateKey, err error) {
+	var data []byte
+	if data, err = os.ReadFile(keyFile); err != nil {
+		return
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		err = fmt.Errorf("no PEM data is found in %q", keyFile)
+		return
+	}
+	if key, err = x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return
+	}
+
+	var parsed any
+	if parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes); err != nil {
+		err = fmt.Errorf("failed to parse the private key: %v", err)
+		return
+	}
+	var ok bool
+	if key, ok = parsed.(*rsa.PrivateKey); !ok {
+		err = fmt.Errorf("the private key in %q is not a RSA key", keyFile)
+	}
+	return
+}
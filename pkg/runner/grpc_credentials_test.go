@@ -0,0 +1,350 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/render"
+	testsrv "github.com/linuxsuren/api-testing/pkg/runner/grpc_test"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	caCert, caKey := writeCertificate(t, dir, "ca", nil, nil)
+	writeCertificate(t, dir, "server", caCert, caKey)
+	writeCertificate(t, dir, "client", caCert, caKey)
+
+	serverCert, err := tls.LoadX509KeyPair(filepath.Join(dir, "server.pem"), filepath.Join(dir, "server.key"))
+	assert.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(caCert)
+
+	s := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+		MinVersion:   tls.VersionTLS13,
+	})))
+	testsrv.RegisterMainServer(s, &testsrv.TestServer{})
+	reflection.RegisterV1(s)
+	l := runServer(t, s)
+	defer s.Stop()
+
+	secure := &atest.Secure{
+		CAFile:     filepath.Join(dir, "ca.pem"),
+		CertFile:   filepath.Join(dir, "client.pem"),
+		KeyFile:    filepath.Join(dir, "client.key"),
+		ServerName: "atest",
+		MinVersion: "1.3",
+	}
+	doGRPCTest(t, l, secure, &atest.RPCDesc{
+		ServerReflection: true,
+	})
+
+	t.Run("without the client certificate", func(t *testing.T) {
+		runUnits([]testUnit{{
+			name: "reflection",
+			testCase: &atest.TestCase{
+				Request: atest.Request{
+					API:  unary,
+					Body: atest.NewRequestBody("{}"),
+				},
+			},
+		}}, t, l, &atest.Secure{
+			CAFile:     filepath.Join(dir, "ca.pem"),
+			ServerName: "atest",
+		}, &atest.RPCDesc{
+			ServerReflection: true,
+		})
+	})
+}
+
+func TestGRPCPerRPCCredentials(t *testing.T) {
+	const token = "Bearer secret"
+	checkToken := func(ctx context.Context) error {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if values := md.Get("authorization"); len(values) == 0 || values[0] != token {
+			return status.Error(codes.Unauthenticated, "invalid token")
+		}
+		return nil
+	}
+
+	s := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := checkToken(ctx); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}), grpc.StreamInterceptor(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkToken(ss.Context()); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}))
+	testsrv.RegisterMainServer(s, &testsrv.TestServer{})
+	reflection.RegisterV1(s)
+	l := runServer(t, s)
+	defer s.Stop()
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id, secret, ok := r.BasicAuth(); !ok || id != "client" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"secret","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer tokenServer.Close()
+
+	doGRPCTest(t, l, nil, &atest.RPCDesc{
+		ServerReflection: true,
+		Credentials: []atest.RPCCredential{{
+			Type:  rpcCredentialBearer,
+			Token: "secret",
+		}},
+	})
+
+	runUnits([]testUnit{{
+		name: "oauth2",
+		testCase: &atest.TestCase{
+			Request: atest.Request{API: unary, Body: atest.NewRequestBody("{}")},
+		},
+		desc: &atest.RPCDesc{
+			ServerReflection: true,
+			Credentials: []atest.RPCCredential{{
+				Type:         rpcCredentialOAuth2,
+				TokenURL:     tokenServer.URL,
+				ClientID:     "client",
+				ClientSecret: "secret",
+			}},
+		},
+		verify: func(t *testing.T, output any, err error) {
+			assert.NoError(t, err)
+		},
+	}, {
+		name: "metadata",
+		testCase: &atest.TestCase{
+			Request: atest.Request{API: unary, Body: atest.NewRequestBody("{}")},
+		},
+		desc: &atest.RPCDesc{
+			ServerReflection: true,
+			Credentials: []atest.RPCCredential{{
+				Type:     rpcCredentialMetadata,
+				Metadata: map[string]string{"authorization": token},
+			}},
+		},
+		verify: func(t *testing.T, output any, err error) {
+			assert.NoError(t, err)
+		},
+	}, {
+		name: "invalid token",
+		testCase: &atest.TestCase{
+			Request: atest.Request{API: unary, Body: atest.NewRequestBody("{}")},
+		},
+		desc: &atest.RPCDesc{
+			ServerReflection: true,
+			Credentials: []atest.RPCCredential{{
+				Type:  rpcCredentialBearer,
+				Token: "fake-token",
+			}},
+		},
+		verify: func(t *testing.T, output any, err error) {
+			assert.ErrorContains(t, err, "invalid token")
+		},
+	}}, t, l, nil, nil)
+}
+
+func TestRenderCredentials(t *testing.T) {
+	t.Setenv("GRPC_CLIENT_SECRET", "client-secret")
+	items := []atest.RPCCredential{{
+		Type:  rpcCredentialBearer,
+		Token: "{{.param.token}}",
+	}, {
+		Type:         rpcCredentialOAuth2,
+		ClientSecret: `{{env "GRPC_CLIENT_SECRET"}}`,
+	}, {
+		Type:     rpcCredentialMetadata,
+		Metadata: map[string]string{"x-api-key": "key-{{.param.token}}"},
+	}}
+	dataContext := map[string]any{
+		atest.ContextKeyGlobalParam: map[string]string{"token": "rendered-token"},
+	}
+
+	result, err := renderCredentials(items, dataContext)
+	assert.NoError(t, err)
+	if assert.Len(t, result, 3) {
+		assert.Equal(t, "rendered-token", result[0].Token)
+		assert.Equal(t, "client-secret", result[1].ClientSecret)
+		assert.Equal(t, "key-rendered-token", result[2].Metadata["x-api-key"])
+	}
+	assert.Equal(t, "key-{{.param.token}}", items[2].Metadata["x-api-key"], "the original one is not changed")
+	assert.Equal(t, render.SecretMask, render.MaskSecrets("client-secret"))
+
+	_, err = renderCredentials([]atest.RPCCredential{{Type: rpcCredentialBearer, Token: "{{.fake"}}, nil)
+	assert.ErrorContains(t, err, "invalid credential 1: failed to render the token")
+}
+
+func TestJWTTokenSource(t *testing.T) {
+	t.Run("HS256", func(t *testing.T) {
+		cred, err := newPerRPCCredential(atest.RPCCredential{
+			Type:   rpcCredentialJWT,
+			Secret: "secret",
+			Claims: map[string]string{"sub": "admin"},
+			TTL:    "1m",
+		})
+		assert.NoError(t, err)
+
+		md, err := cred.GetRequestMetadata(context.TODO())
+		assert.NoError(t, err)
+		jwt := strings.TrimPrefix(md["authorization"], "Bearer ")
+		parts := strings.Split(jwt, ".")
+		assert.Len(t, parts, 3)
+
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte(parts[0] + "." + parts[1]))
+		assert.Equal(t, base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), parts[2])
+
+		claims := decodeJWTPart(t, parts[1])
+		assert.Equal(t, "admin", claims["sub"])
+		assert.InDelta(t, time.Now().Add(time.Minute).Unix(), claims["exp"], 5)
+	})
+
+	t.Run("RS256", func(t *testing.T) {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		assert.NoError(t, err)
+		data, err := x509.MarshalPKCS8PrivateKey(key)
+		assert.NoError(t, err)
+		keyFile := filepath.Join(t.TempDir(), "jwt.key")
+		assert.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: data}), 0600))
+
+		source, err := newJWTTokenSource(atest.RPCCredential{KeyFile: keyFile})
+		assert.NoError(t, err)
+		token, err := source.Token()
+		assert.NoError(t, err)
+
+		parts := strings.Split(token.AccessToken, ".")
+		assert.Equal(t, "RS256", decodeJWTPart(t, parts[0])["alg"])
+		signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+		assert.NoError(t, err)
+		digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+		assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := newPerRPCCredential(atest.RPCCredential{Type: rpcCredentialJWT})
+		assert.Error(t, err)
+		_, err = newPerRPCCredential(atest.RPCCredential{Type: rpcCredentialJWT, Secret: "secret", TTL: "fake"})
+		assert.Error(t, err)
+		_, err = newPerRPCCredential(atest.RPCCredential{Type: rpcCredentialJWT, KeyFile: "fake"})
+		assert.Error(t, err)
+		_, err = newPerRPCCredential(atest.RPCCredential{Type: rpcCredentialBearer})
+		assert.Error(t, err)
+		_, err = newPerRPCCredential(atest.RPCCredential{Type: rpcCredentialOAuth2})
+		assert.Error(t, err)
+		_, err = newPerRPCCredential(atest.RPCCredential{Type: rpcCredentialMetadata})
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildTLSConfig(t *testing.T) {
+	config, err := buildTLSConfig(&atest.Secure{
+		CertFile:   "grpc_test/testassets/server.pem",
+		ServerName: "atest",
+		MinVersion: "TLS1.2",
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, config.RootCAs)
+	assert.Empty(t, config.Certificates)
+	assert.Equal(t, uint16(tls.VersionTLS12), config.MinVersion)
+
+	_, err = buildTLSConfig(&atest.Secure{MinVersion: "2.0"})
+	assert.Error(t, err)
+	_, err = buildTLSConfig(&atest.Secure{CAFile: "fake"})
+	assert.Error(t, err)
+	_, err = buildTLSConfig(&atest.Secure{CAFile: "grpc_test/test.proto"})
+	assert.Error(t, err)
+	_, err = buildTLSConfig(&atest.Secure{CertFile: "grpc_test/testassets/server.pem", KeyFile: "fake"})
+	assert.Error(t, err)
+}
+
+func decodeJWTPart(t *testing.T, part string) (result map[string]any) {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(data, &result))
+	return
+}
+
+// writeCertificate writes the certificate and key as name.pem and name.key,
+// it is a self-signed CA if the parent is nil
+func writeCertificate(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *rsa.PrivateKey) (*x509.Certificate, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"atest"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, name+".pem"),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, name+".key"),
+		pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), 0600))
+	return cert, key
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/compare"
+	"github.com/linuxsuren/api-testing/pkg/render"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+
+	"github.com/tidwall/gjson"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const defaultStreamStepTimeout = 10 * time.Second
+
+type streamMessage struct {
+	message *dynamicpb.Message
+	err     error
+}
+
+// streamSession runs the scripted steps on a gRPC stream,
+// the messages are received in background so that the expect step could time out
+type streamSession struct {
+	stream   grpc.ClientStream
+	md       protoreflect.MethodDescriptor
+	received chan streamMessage
+	messages []*dynamicpb.Message
+	// err is the terminal error of the stream, it is io.EOF if the stream finished normally
+	err        error
+	sendClosed bool
+}
+
+// runStreamSteps runs the stream steps of the test case, each step is recorded separately
+func (r *gRPCTestCaseRunner) runStreamSteps(ctx context.Context, testcase *testing.TestCase, dataContext any,
+	md protoreflect.MethodDescriptor, conn grpc.ClientConnInterface) (resp *grpcResponse, err error) {
+	if !md.IsStreamingClient() && !md.IsStreamingServer() {
+		err = fmt.Errorf("the stream steps require a streaming RPC, but %q is unary", md.FullName())
+		return
+	}
+	if protocol := r.proto.Protocol; protocol == grpcProtocolWeb || protocol == grpcProtocolConnect {
+		if err = checkHalfDuplexSteps(protocol, testcase.Stream); err != nil {
+			return
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	resp = &grpcResponse{}
+	session := &streamSession{
+		md:       md,
+		received: make(chan streamMessage),
+	}
+	if session.stream, err = conn.NewStream(ctx, &grpc.StreamDesc{
+		StreamName:    string(md.Name()),
+		ServerStreams: md.IsStreamingServer(),
+		ClientStreams: md.IsStreamingClient(),
+	}, getMethodName(md), grpc.Header(&resp.header), grpc.Trailer(&resp.trailer)); err != nil {
+		return
+	}
+	go session.receive(ctx)
+
+	for i, step := range testcase.Stream {
+		record := NewReportRecord()
+		record.Group = testcase.Group
+		record.Name = fmt.Sprintf("%s step %d %s", testcase.Name, i+1, step.Kind())
+		record.API = testcase.Request.API
+		record.Method = "gRPC"
+
+		err = session.run(ctx, record.Name, step, dataContext)
+		record.EndTime = time.Now()
+		record.Error = err
+		r.testReporter.PutRecord(record)
+		if err != nil {
+			err = fmt.Errorf("step %d %s: %v", i+1, step.Kind(), err)
+			return
+		}
+	}
+
+	if err = session.finish(ctx, defaultStreamStepTimeout); err != nil {
+		return
+	}
+	if resp.messages, err = buildResponses(session.messages); err != nil {
+		return
+	}
+	if !errors.Is(session.err, io.EOF) {
+		var ok bool
+		if resp.status, ok = status.FromError(session.err); !ok {
+			err = session.err
+		}
+	}
+	return
+}
+
+// checkHalfDuplexSteps makes sure no message is expected before closeSend, since
+// the requests are sent together once the sending side is closed
+func checkHalfDuplexSteps(protocol string, steps []testing.StreamStep) error {
+	for i, step := range steps {
+		switch step.Kind() {
+		case "closeSend":
+			return nil
+		case "expect":
+			return fmt.Errorf("step %d expect: the messages are received after closeSend over the %s protocol, add a closeSend step before it",
+				i+1, protocol)
+		}
+	}
+	return nil
+}
+
+func (s *streamSession) receive(ctx context.Context) {
+	defer close(s.received)
+	for {
+		message := dynamicpb.NewMessage(s.md.Output())
+		err := s.stream.RecvMsg(message)
+		select {
+		case s.received <- streamMessage{message: message, err: err}:
+		case <-ctx.Done():
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *streamSession) run(ctx context.Context, name string, step testing.StreamStep, dataContext any) (err error) {
+	timeout := defaultStreamStepTimeout
+	if step.Timeout != "" {
+		if timeout, err = time.ParseDuration(step.Timeout); err != nil {
+			err = fmt.Errorf("invalid timeout: %v", err)
+			return
+		}
+	}
+
+	switch step.Kind() {
+	case "send":
+		var payload string
+		var message *dynamicpb.Message
+		if payload, err = render.Render("stream send", step.Send, dataContext); err != nil {
+			return
+		}
+		if message, err = getMessagePb(s.md.Input(), payload); err == nil {
+			err = s.stream.SendMsg(message)
+		}
+	case "expect":
+		var payload string
+		var message *dynamicpb.Message
+		if payload, err = render.Render("stream expect", step.Expect, dataContext); err != nil {
+			return
+		}
+		if message, err = s.next(ctx, timeout); err == nil {
+			err = expectStreamMessage(name, s.md, payload, message)
+		}
+	case "wait":
+		var duration time.Duration
+		if duration, err = time.ParseDuration(step.Wait); err != nil {
+			err = fmt.Errorf("invalid wait duration: %v", err)
+			return
+		}
+		select {
+		case <-time.After(duration):
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	case "closeSend":
+		err = s.closeSend()
+	default:
+		err = errors.New("one of send, expect, wait and closeSend is required")
+	}
+	return
+}
+
+// next returns the next received message, it fails if there is no message in time
+func (s *streamSession) next(ctx context.Context, timeout time.Duration) (message *dynamicpb.Message, err error) {
+	if s.err != nil {
+		err = fmt.Errorf("the stream is closed: %v", s.err)
+		return
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case received, ok := <-s.received:
+		switch {
+		case !ok:
+			err = ctx.Err()
+		case received.err != nil:
+			s.err = received.err
+			err = fmt.Errorf("the stream is closed: %v", received.err)
+		default:
+			message = received.message
+			s.messages = append(s.messages, message)
+		}
+	case <-timer.C:
+		err = fmt.Errorf("no message received in %v", timeout)
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	return
+}
+
+func (s *streamSession) closeSend() (err error) {
+	if !s.sendClosed {
+		s.sendClosed = true
+		err = s.stream.CloseSend()
+	}
+	return
+}
+
+// finish closes the sending direction and collects the rest messages,
+// it fails if the server does not finish the stream in time
+func (s *streamSession) finish(ctx context.Context, timeout time.Duration) (err error) {
+	if err = s.closeSend(); err != nil {
+		return
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for s.err == nil {
+		select {
+		case received, ok := <-s.received:
+			switch {
+			case !ok:
+				return ctx.Err()
+			case received.err != nil:
+				s.err = received.err
+			default:
+				s.messages = append(s.messages, received.message)
+			}
+		case <-timer.C:
+			return fmt.Errorf("the stream is not finished by the server in %v", timeout)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return
+}
+
+// expectStreamMessage checks the fields of the expected message
+func expectStreamMessage(name string, md protoreflect.MethodDescriptor, expect string, message *dynamicpb.Message) (err error) {
+	var expected *dynamicpb.Message
+	if expected, err = getMessagePb(md.Output(), expect); err != nil {
+		return
+	}
+
+	// the default values are emitted, then only the given fields are compared
+	marshaler := protojson.MarshalOptions{EmitUnpopulated: true}
+	var expectData, actualData []byte
+	if expectData, err = marshaler.Marshal(expected); err != nil {
+		return
+	}
+	if actualData, err = marshaler.Marshal(message); err != nil {
+		return
+	}
+	expectFields := givenFields(md.Output(), gjson.Parse(expect), gjson.ParseBytes(expectData))
+	return compare.Object(name, gjson.ParseBytes(expectFields).Map(), gjson.ParseBytes(actualData).Map())
+}
+
+// givenFields keeps the fields of the normalized message which are given in the expectation
+func givenFields(md protoreflect.MessageDescriptor, given, normalized gjson.Result) json.RawMessage {
+	values := normalized.Map()
+	fields := map[string]json.RawMessage{}
+	given.ForEach(func(key, value gjson.Result) bool {
+		field := md.Fields().ByJSONName(key.String())
+		if field == nil {
+			field = md.Fields().ByName(protoreflect.Name(key.String()))
+		}
+		if field == nil {
+			return true
+		}
+
+		name := field.JSONName()
+		result, ok := values[name]
+		if !ok {
+			return true
+		}
+		switch {
+		case field.Message() == nil || field.IsMap():
+			fields[name] = json.RawMessage(result.Raw)
+		case field.IsList() && value.IsArray() && len(value.Array()) == len(result.Array()):
+			items := make([]json.RawMessage, 0, len(value.Array()))
+			for i, item := range result.Array() {
+				items = append(items, givenFields(field.Message(), value.Array()[i], item))
+			}
+			data, _ := json.Marshal(items)
+			fields[name] = data
+		case !field.IsList() && value.IsObject() && result.IsObject():
+			fields[name] = givenFields(field.Message(), value, result)
+		default:
+			fields[name] = json.RawMessage(result.Raw)
+		}
+		return true
+	})
+	data, _ := json.Marshal(fields)
+	return data
+}
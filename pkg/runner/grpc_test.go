@@ -35,8 +35,10 @@ import (
 	fakeruntime "github.com/linuxsuren/go-fake-runtime"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -268,7 +270,273 @@ func TestGRPCTestError(t *testing.T) {
 	runUnits(tests, t, l, nil, &atest.RPCDesc{})
 }
 
-func runServer(t *testing.T, s *grpc.Server) net.Listener {
+func TestGRPCStatus(t *testing.T) {
+	s := grpc.NewServer()
+	testsrv.RegisterMainServer(s, &testsrv.TestServer{})
+	l := runServer(t, s)
+	defer s.Stop()
+
+	errorRequest := atest.Request{
+		API:    unary,
+		Body:   atest.NewRequestBody("{}"),
+		Header: map[string]string{"status": "missing"},
+	}
+	runUnits([]testUnit{
+		{
+			name: "expect the error status",
+			testCase: &atest.TestCase{
+				Request: errorRequest,
+				Expect: atest.Response{
+					GRPCCode: "NOT_FOUND",
+					Verify: []string{
+						`code == "NOT_FOUND"`,
+						`message == "missing"`,
+						`details[0]["@type"] == "type.googleapis.com/google.rpc.BadRequest"`,
+						`details[0].fieldViolations[0].field == "name"`,
+						`header["x-request-id"] == "1"`,
+						`trailer["x-trace-id"] == "2"`,
+					},
+				},
+			},
+			verify: func(t *testing.T, output any, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "expect the code number",
+			testCase: &atest.TestCase{
+				Request: errorRequest,
+				Expect:  atest.Response{GRPCCode: "5"},
+			},
+			verify: func(t *testing.T, output any, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "unexpected error status",
+			testCase: &atest.TestCase{
+				Request: errorRequest,
+			},
+			verify: func(t *testing.T, output any, err error) {
+				assert.ErrorContains(t, err, "code = NotFound desc = missing")
+			},
+		},
+		{
+			name: "unexpected code",
+			testCase: &atest.TestCase{
+				Request: errorRequest,
+				Expect:  atest.Response{GRPCCode: "ALREADY_EXISTS"},
+			},
+			verify: func(t *testing.T, output any, err error) {
+				assert.ErrorContains(t, err, "expect gRPC code ALREADY_EXISTS, but got NOT_FOUND: missing")
+			},
+		},
+		{
+			name: "expect OK",
+			testCase: &atest.TestCase{
+				Request: atest.Request{API: unary, Body: atest.NewRequestBody("{}")},
+				Expect: atest.Response{
+					GRPCCode: "OK",
+					Verify:   []string{`code == "OK"`, `len(details) == 0`},
+				},
+			},
+			verify: func(t *testing.T, output any, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}, t, l, nil, &atest.RPCDesc{
+		ImportPath: []string{"grpc_test"},
+		ProtoFile:  "test.proto",
+	})
+
+	t.Run("response record", func(t *testing.T) {
+		runner := NewGRPCTestCaseRunner(l.Addr().String(), atest.RPCDesc{
+			ImportPath: []string{"grpc_test"},
+			ProtoFile:  "test.proto",
+		})
+		request := errorRequest
+		request.API = l.Addr().String() + unary
+		_, err := runner.RunTestCase(&atest.TestCase{
+			Request: request,
+			Expect:  atest.Response{GRPCCode: "NOT_FOUND"},
+		}, nil, context.TODO())
+		assert.NoError(t, err)
+
+		header := runner.(ResponseRecord).GetResponseRecord().Header
+		assert.Equal(t, "1", header["x-request-id"])
+		assert.Equal(t, "2", header["x-trace-id"])
+		assert.Equal(t, "5", header["grpc-status"])
+		assert.Equal(t, "missing", header["grpc-message"])
+	})
+
+	t.Run("capture", func(t *testing.T) {
+		runner := NewGRPCTestCaseRunner(l.Addr().String(), atest.RPCDesc{
+			ImportPath: []string{"grpc_test"},
+			ProtoFile:  "test.proto",
+		})
+		request := errorRequest
+		request.API = l.Addr().String() + unary
+		dataContext := map[string]any{}
+		_, err := runner.RunTestCase(&atest.TestCase{
+			Request: request,
+			Expect:  atest.Response{GRPCCode: "NOT_FOUND"},
+			Capture: []atest.Capture{
+				{Name: "requestID", Type: atest.CaptureTypeHeader, Expression: "x-request-id"},
+				{Name: "traceID", Type: atest.CaptureTypeHeader, Expression: "x-trace-id"},
+				{Name: "code", Type: atest.CaptureTypeStatus},
+			},
+		}, dataContext, context.TODO())
+		assert.NoError(t, err)
+
+		_, err = runner.RunTestCase(&atest.TestCase{
+			Request: atest.Request{
+				API:    l.Addr().String() + unary,
+				Body:   atest.NewRequestBody("{}"),
+				Header: map[string]string{"message": "hello"},
+			},
+			Capture: []atest.Capture{{Name: "message", Type: atest.CaptureTypeJSON, Expression: "message"}},
+		}, dataContext, context.TODO())
+		assert.NoError(t, err)
+
+		vars := atest.GetVariables(dataContext)
+		for name, expected := range map[string]any{"requestID": "1", "traceID": "2", "code": 5, "message": "hello"} {
+			value, err := vars.Get(name)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, value, name)
+		}
+	})
+}
+
+func TestGRPCStreamSteps(t *testing.T) {
+	s := grpc.NewServer()
+	testsrv.RegisterMainServer(s, &testsrv.TestServer{})
+	l := runServer(t, s)
+	defer s.Stop()
+
+	desc := atest.RPCDesc{
+		ImportPath: []string{"grpc_test"},
+		ProtoFile:  "test.proto",
+	}
+	run := func(api string, steps ...atest.StreamStep) (TestReporter, any, error) {
+		reporter := NewMemoryTestReporter(nil, "")
+		runner := NewGRPCTestCaseRunner(l.Addr().String(), desc)
+		runner.WithTestReporter(reporter)
+		output, err := runner.RunTestCase(&atest.TestCase{
+			Name: "stream",
+			Request: atest.Request{
+				API: l.Addr().String() + api,
+			},
+			Expect: atest.Response{
+				Verify: []string{`code == "OK"`},
+			},
+			Stream: steps,
+		}, map[string]any{"id": 2}, context.TODO())
+		return reporter, output, err
+	}
+
+	t.Run("bidirectional stream", func(t *testing.T) {
+		reporter, _, err := run(bidStream,
+			atest.StreamStep{Send: `{"MsgID": 1}`},
+			atest.StreamStep{Expect: `{"MsgID": 1}`, Timeout: "1s"},
+			atest.StreamStep{Wait: "10ms"},
+			atest.StreamStep{Send: `{"MsgID": {{.id}}}`},
+			atest.StreamStep{Expect: `{"MsgID": 2}`},
+			atest.StreamStep{CloseSend: true},
+		)
+		assert.NoError(t, err)
+
+		var names []string
+		for _, record := range reporter.GetAllRecords() {
+			assert.NoError(t, record.Error)
+			names = append(names, record.Name)
+		}
+		assert.Equal(t, []string{"stream step 1 send", "stream step 2 expect", "stream step 3 wait",
+			"stream step 4 send", "stream step 5 expect", "stream step 6 closeSend", "stream"}, names)
+	})
+
+	t.Run("server stream", func(t *testing.T) {
+		_, _, err := run(serverStream,
+			atest.StreamStep{Send: `{"data": [{"MsgID": 1}, {"MsgID": 2}]}`},
+			atest.StreamStep{Expect: `{"MsgID": 1}`},
+			atest.StreamStep{Expect: `{"MsgID": 2}`},
+		)
+		assert.NoError(t, err)
+	})
+
+	t.Run("the default values", func(t *testing.T) {
+		_, _, err := run(serverStream,
+			atest.StreamStep{Send: `{"data": [{"MsgID": 1}, {"MsgID": 0}]}`},
+			atest.StreamStep{Expect: `{"MsgID": 1}`},
+			atest.StreamStep{Expect: `{"MsgID": 0, "ExpectLen": 0}`},
+		)
+		assert.NoError(t, err)
+
+		_, _, err = run(serverStream,
+			atest.StreamStep{Send: `{"data": [{"MsgID": 1}]}`},
+			atest.StreamStep{Expect: `{"MsgID": 0}`},
+		)
+		assert.ErrorContains(t, err, "step 2 expect")
+	})
+
+	t.Run("unexpected message", func(t *testing.T) {
+		reporter, _, err := run(bidStream,
+			atest.StreamStep{Send: `{"MsgID": 1}`},
+			atest.StreamStep{Expect: `{"MsgID": 3}`},
+			atest.StreamStep{Send: `{"MsgID": 2}`},
+		)
+		assert.ErrorContains(t, err, "step 2 expect")
+		records := reporter.GetAllRecords()
+		if assert.Len(t, records, 3) {
+			assert.Error(t, records[1].Error)
+		}
+	})
+
+	t.Run("no message in time", func(t *testing.T) {
+		_, _, err := run(bidStream, atest.StreamStep{Expect: `{"MsgID": 1}`, Timeout: "50ms"})
+		assert.ErrorContains(t, err, "no message received in 50ms")
+	})
+
+	t.Run("the stream is not finished in time", func(t *testing.T) {
+		session := &streamSession{received: make(chan streamMessage), sendClosed: true}
+		err := session.finish(context.TODO(), 10*time.Millisecond)
+		assert.ErrorContains(t, err, "the stream is not finished by the server in 10ms")
+	})
+
+	t.Run("the stream is closed", func(t *testing.T) {
+		_, _, err := run(bidStream,
+			atest.StreamStep{CloseSend: true},
+			atest.StreamStep{Expect: `{"MsgID": 1}`},
+		)
+		assert.ErrorContains(t, err, "the stream is closed")
+	})
+
+	t.Run("invalid steps", func(t *testing.T) {
+		_, _, err := run(unary, atest.StreamStep{Send: `{}`})
+		assert.ErrorContains(t, err, "require a streaming RPC")
+
+		_, _, err = run(bidStream, atest.StreamStep{})
+		assert.ErrorContains(t, err, "one of send, expect, wait and closeSend is required")
+
+		_, _, err = run(bidStream, atest.StreamStep{Wait: "x"})
+		assert.ErrorContains(t, err, "invalid wait duration")
+	})
+}
+
+func TestGRPCCode(t *testing.T) {
+	for _, name := range []string{"NOT_FOUND", "not_found", "5"} {
+		code, err := parseGRPCCode(name)
+		assert.NoError(t, err)
+		assert.Equal(t, codes.NotFound, code)
+	}
+	_, err := parseGRPCCode("unknown code")
+	assert.ErrorContains(t, err, "invalid gRPC code")
+
+	assert.Equal(t, "OK", grpcCodeName(codes.OK))
+	assert.Equal(t, "DEADLINE_EXCEEDED", grpcCodeName(codes.DeadlineExceeded))
+	assert.Equal(t, "INVALID_ARGUMENT", grpcCodeName(codes.InvalidArgument))
+}
+
+func runServer(t testing.TB, s *grpc.Server) net.Listener {
 	l, err := net.Listen("tcp", "127.0.0.1:0")
 	assert.NoError(t, err, "Listen port")
 
@@ -672,6 +940,38 @@ func TestGRPCGetSuggestedAPIs(t *testing.T) {
 		}, "")
 		assert.Error(t, err, err)
 	})
+
+	t.Run("reflection v1alpha", func(t *testing.T) {
+		s := grpc.NewServer()
+		testsrv.RegisterMainServer(s, &testsrv.TestServer{})
+		grpc_reflection_v1alpha.RegisterServerReflectionServer(s, reflection.NewServer(reflection.ServerOptions{Services: s}))
+		l := runServer(t, s)
+		defer s.Stop()
+
+		desc := atest.RPCDesc{ServerReflection: true}
+		runner := NewGRPCTestCaseRunner(l.Addr().String(), desc)
+		result, err := runner.GetSuggestedAPIs(&atest.TestSuite{
+			API:  l.Addr().String(),
+			Spec: atest.APISpec{RPC: &desc},
+		}, "")
+		assert.NoError(t, err)
+		assert.NotEmpty(t, result)
+
+		// the connection of the suggestion is not kept in the default pool
+		defaultGRPCConnectionPool.mu.Lock()
+		for key := range defaultGRPCConnectionPool.conns {
+			assert.NotEqual(t, l.Addr().String(), key.host)
+		}
+		defaultGRPCConnectionPool.mu.Unlock()
+
+		_, err = runner.RunTestCase(&atest.TestCase{
+			Request: atest.Request{
+				API:  l.Addr().String() + unary,
+				Body: atest.NewRequestBody("{}"),
+			},
+		}, nil, context.TODO())
+		assert.NoError(t, err)
+	})
 }
 
 // getJSONOrCache can store the JSON string of value.
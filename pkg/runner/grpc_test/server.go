@@ -20,7 +20,11 @@ import (
 	"context"
 	"io"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type TestServer struct {
@@ -35,6 +39,9 @@ func (s *TestServer) Unary(ctx context.Context, _ *Empty) (*HelloReply, error) {
 		if items := md.Get("message"); len(items) > 0 {
 			msg = items[0]
 		}
+		if items := md.Get("status"); len(items) > 0 {
+			return nil, errorStatus(ctx, items[0])
+		}
 	}
 
 	if msg == "" {
@@ -46,6 +53,22 @@ func (s *TestServer) Unary(ctx context.Context, _ *Empty) (*HelloReply, error) {
 	}, nil
 }
 
+// errorStatus returns the NOT_FOUND status with the metadata and details
+func errorStatus(ctx context.Context, msg string) error {
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-request-id", "1"))
+	_ = grpc.SetTrailer(ctx, metadata.Pairs("x-trace-id", "2"))
+	sta, err := status.New(codes.NotFound, msg).WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       "name",
+			Description: msg,
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	return sta.Err()
+}
+
 func (s *TestServer) ClientStream(stream Main_ClientStreamServer) error {
 	msgs := make([]*StreamMessage, 0)
 	for {
@@ -72,27 +95,20 @@ func (s *TestServer) ServerStream(msg *StreamMessageRepeated, stream Main_Server
 	return nil
 }
 
+// BidStream echoes the messages one by one
 func (s *TestServer) BidStream(stream Main_BidStreamServer) error {
-	msgs := make([]*StreamMessage, 0)
 	for {
 		v, err := stream.Recv()
 		if err != nil {
 			if err == io.EOF {
-				break
+				return nil
 			}
 			return err
 		}
-		msgs = append(msgs, v)
-	}
-
-	var err error
-	for i := range msgs {
-		err = stream.Send(msgs[i])
-		if err != nil {
+		if err = stream.Send(v); err != nil {
 			return err
 		}
 	}
-	return nil
 }
 
 func (s *TestServer) TestBasicType(ctx context.Context, baiscType *BasicType) (*BasicType, error) {
@@ -0,0 +1,547 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// the wire protocols of the gRPC runner
+const (
+	grpcProtocolNative  = "grpc"
+	grpcProtocolWeb     = "grpc-web"
+	grpcProtocolConnect = "connect"
+)
+
+const (
+	envelopeFlagCompressed = 0x01
+	envelopeFlagEndStream  = 0x02
+	envelopeFlagTrailer    = 0x80
+)
+
+// maxHTTPRPCMessageSize is the max size of a received message, it is the same as the default of grpc-go
+const maxHTTPRPCMessageSize = 4 << 20
+
+// httpRPCConn calls the gRPC methods over the gRPC-Web or the Connect protocol.
+// The streams are half-duplex, the request messages are sent together once
+// the sending side is closed.
+type httpRPCConn struct {
+	protocol string
+	baseURL  string
+	client   *http.Client
+	perRPC   []credentials.PerRPCCredentials
+}
+
+var _ grpc.ClientConnInterface = &httpRPCConn{}
+
+func newHTTPRPCConn(protocol, host string, secure *testing.Secure, perRPC []credentials.PerRPCCredentials) (conn *httpRPCConn, err error) {
+	conn = &httpRPCConn{
+		protocol: protocol,
+		baseURL:  "http://" + host,
+		client:   &http.Client{},
+		perRPC:   perRPC,
+	}
+	if secure == nil || secure.Insecure {
+		return
+	}
+
+	var tlsConfig *tls.Config
+	if tlsConfig, err = buildTLSConfig(secure); err != nil {
+		return
+	}
+	conn.baseURL = "https://" + host
+	conn.client.Transport = &http.Transport{
+		TLSClientConfig:   tlsConfig,
+		ForceAttemptHTTP2: true,
+	}
+	return
+}
+
+func (c *httpRPCConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) (err error) {
+	if c.protocol == grpcProtocolConnect {
+		return c.invokeConnectUnary(ctx, method, args, reply, opts...)
+	}
+
+	var stream grpc.ClientStream
+	if stream, err = c.NewStream(ctx, &grpc.StreamDesc{}, method, opts...); err != nil {
+		return
+	}
+	if err = stream.SendMsg(args); err != nil {
+		return
+	}
+	if err = stream.CloseSend(); err != nil {
+		return
+	}
+	if err = stream.RecvMsg(reply); err == io.EOF {
+		return status.Error(codes.Internal, "no response message for an unary call")
+	} else if err != nil {
+		return
+	}
+	if err = stream.RecvMsg(nil); err == io.EOF {
+		err = nil
+	}
+	return
+}
+
+func (c *httpRPCConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream := &httpRPCStream{
+		conn:   c,
+		ctx:    ctx,
+		method: method,
+		sent:   make(chan struct{}),
+	}
+	stream.headerAddr, stream.trailerAddr = metadataCallOptions(opts)
+	return stream, nil
+}
+
+// Close releases the idle connections
+func (c *httpRPCConn) Close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
+
+func (c *httpRPCConn) newRequest(ctx context.Context, method, contentType string, body []byte) (req *http.Request, err error) {
+	if req, err = http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+method, bytes.NewReader(body)); err != nil {
+		return
+	}
+
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		for key, values := range md {
+			for _, value := range values {
+				req.Header.Add(key, value)
+			}
+		}
+	}
+	for _, cred := range c.perRPC {
+		var md map[string]string
+		if md, err = cred.GetRequestMetadata(ctx, c.baseURL+method); err != nil {
+			err = status.Error(codes.Unauthenticated, err.Error())
+			return
+		}
+		for key, value := range md {
+			req.Header.Set(key, value)
+		}
+	}
+	req.Header.Set(util.ContentType, contentType)
+	switch c.protocol {
+	case grpcProtocolWeb:
+		req.Header.Set("X-Grpc-Web", "1")
+		req.Header.Set("Accept", contentType)
+	case grpcProtocolConnect:
+		req.Header.Set("Connect-Protocol-Version", "1")
+	}
+	return
+}
+
+func (c *httpRPCConn) invokeConnectUnary(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) (err error) {
+	headerAddr, trailerAddr := metadataCallOptions(opts)
+
+	var body []byte
+	if body, err = proto.Marshal(args.(proto.Message)); err != nil {
+		return
+	}
+
+	var req *http.Request
+	if req, err = c.newRequest(ctx, method, "application/proto", body); err != nil {
+		return
+	}
+
+	var resp *http.Response
+	if resp, err = c.client.Do(req); err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer resp.Body.Close()
+
+	header, trailer := metadata.MD{}, metadata.MD{}
+	for key, values := range resp.Header {
+		key = strings.ToLower(key)
+		if name, ok := strings.CutPrefix(key, "trailer-"); ok {
+			trailer.Append(name, values...)
+		} else {
+			header.Append(key, values...)
+		}
+	}
+	setMetadata(headerAddr, header)
+	setMetadata(trailerAddr, trailer)
+
+	if body, err = io.ReadAll(io.LimitReader(resp.Body, maxHTTPRPCMessageSize+1)); err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	if len(body) > maxHTTPRPCMessageSize {
+		return status.Errorf(codes.ResourceExhausted, "received message larger than max (%d bytes)", maxHTTPRPCMessageSize)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return parseConnectError(resp.StatusCode, body)
+	}
+	if err = proto.Unmarshal(body, reply.(proto.Message)); err != nil {
+		err = status.Errorf(codes.Internal, "failed to unmarshal the response: %v", err)
+	}
+	return
+}
+
+// httpRPCStream is a half-duplex stream of the gRPC-Web or the Connect protocol
+type httpRPCStream struct {
+	conn        *httpRPCConn
+	ctx         context.Context
+	method      string
+	headerAddr  *metadata.MD
+	trailerAddr *metadata.MD
+
+	mu       sync.Mutex
+	requests [][]byte
+	closed   bool
+	sent     chan struct{}
+
+	body    *bufio.Reader
+	closer  io.Closer
+	header  metadata.MD
+	trailer metadata.MD
+	err     error
+}
+
+var _ grpc.ClientStream = &httpRPCStream{}
+
+func (s *httpRPCStream) Header() (metadata.MD, error) {
+	select {
+	case <-s.sent:
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+	if s.header == nil {
+		return nil, s.err
+	}
+	return s.header, nil
+}
+
+func (s *httpRPCStream) Trailer() metadata.MD {
+	return s.trailer
+}
+
+func (s *httpRPCStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *httpRPCStream) SendMsg(m any) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return fmt.Errorf("cannot send the message after closing send over the %s protocol", s.conn.protocol)
+	}
+
+	var data []byte
+	if data, err = proto.Marshal(m.(proto.Message)); err == nil {
+		s.requests = append(s.requests, data)
+	}
+	return
+}
+
+// CloseSend sends all the request messages
+func (s *httpRPCStream) CloseSend() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	defer close(s.sent)
+
+	contentType := "application/grpc-web+proto"
+	if s.conn.protocol == grpcProtocolConnect {
+		contentType = "application/connect+proto"
+	}
+
+	var body bytes.Buffer
+	for _, data := range s.requests {
+		writeEnvelope(&body, 0, data)
+	}
+
+	req, err := s.conn.newRequest(s.ctx, s.method, contentType, body.Bytes())
+	if err != nil {
+		s.finish(err)
+		return nil
+	}
+
+	resp, err := s.conn.client.Do(req)
+	if err != nil {
+		s.finish(status.Error(codes.Unavailable, err.Error()))
+		return nil
+	}
+
+	s.closer = resp.Body
+	s.body = bufio.NewReader(resp.Body)
+	s.header = metadata.MD{}
+	for key, values := range resp.Header {
+		s.header.Append(strings.ToLower(key), values...)
+	}
+	setMetadata(s.headerAddr, s.header)
+
+	if resp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, maxHTTPRPCMessageSize))
+		s.finish(status.Error(codeFromHTTPStatus(resp.StatusCode), strings.TrimSpace(string(data))))
+	} else if s.conn.protocol == grpcProtocolWeb && resp.Header.Get("Grpc-Status") != "" {
+		// trailers-only response
+		s.finish(statusFromTrailer(resp.Header))
+	}
+	return nil
+}
+
+// RecvMsg receives the next response message, it waits until the requests are sent
+func (s *httpRPCStream) RecvMsg(m any) (err error) {
+	select {
+	case <-s.sent:
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for s.err == nil {
+		flag, data, readErr := readEnvelope(s.body)
+		switch {
+		case readErr == io.EOF && s.conn.protocol == grpcProtocolWeb:
+			s.finish(status.Error(codes.Internal, "the response stream is finished without trailers"))
+		case readErr == io.EOF:
+			s.finish(status.Error(codes.Internal, "the response stream is finished without the end message"))
+		case status.Code(readErr) == codes.ResourceExhausted:
+			s.finish(readErr)
+		case readErr != nil:
+			s.finish(status.Error(codes.Internal, readErr.Error()))
+		case flag&envelopeFlagCompressed != 0:
+			s.finish(status.Error(codes.Internal, "the compressed message is not supported"))
+		case s.conn.protocol == grpcProtocolWeb && flag&envelopeFlagTrailer != 0:
+			s.finish(s.parseWebTrailer(data))
+		case s.conn.protocol == grpcProtocolConnect && flag&envelopeFlagEndStream != 0:
+			s.finish(s.parseConnectEndStream(data))
+		case m == nil:
+			s.finish(status.Error(codes.Internal, "received more than one response message for an unary call"))
+		default:
+			if err = proto.Unmarshal(data, m.(proto.Message)); err != nil {
+				err = status.Errorf(codes.Internal, "failed to unmarshal the response: %v", err)
+			}
+			return
+		}
+	}
+	return s.err
+}
+
+// finish ends the stream with the error, it is io.EOF if the call succeeds
+func (s *httpRPCStream) finish(err error) {
+	if s.err != nil {
+		return
+	}
+	if err == nil {
+		err = io.EOF
+	}
+	s.err = err
+	if s.trailer == nil {
+		s.trailer = metadata.MD{}
+	}
+	setMetadata(s.trailerAddr, s.trailer)
+	if s.closer != nil {
+		_ = s.closer.Close()
+	}
+}
+
+func (s *httpRPCStream) parseWebTrailer(data []byte) error {
+	reader := textproto.NewReader(bufio.NewReader(io.MultiReader(bytes.NewReader(data), strings.NewReader("\r\n"))))
+	header, err := reader.ReadMIMEHeader()
+	if err != nil && err != io.EOF {
+		return status.Errorf(codes.Internal, "invalid trailers: %v", err)
+	}
+
+	s.trailer = metadata.MD{}
+	for key, values := range header {
+		key = strings.ToLower(key)
+		if !strings.HasPrefix(key, "grpc-") {
+			s.trailer.Append(key, values...)
+		}
+	}
+	return statusFromTrailer(http.Header(header))
+}
+
+func (s *httpRPCStream) parseConnectEndStream(data []byte) error {
+	end := &struct {
+		Error    *connectError       `json:"error"`
+		Metadata map[string][]string `json:"metadata"`
+	}{}
+	if err := json.Unmarshal(data, end); err != nil {
+		return status.Errorf(codes.Internal, "invalid end stream message: %v", err)
+	}
+
+	s.trailer = metadata.MD{}
+	for key, values := range end.Metadata {
+		s.trailer.Append(strings.ToLower(key), values...)
+	}
+	if end.Error == nil {
+		return nil
+	}
+	return end.Error.status(codes.Unknown)
+}
+
+// connectError is the error of the Connect protocol
+type connectError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Details []struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	} `json:"details"`
+}
+
+func (e *connectError) status(fallback codes.Code) error {
+	code := fallback
+	if e.Code == "canceled" {
+		code = codes.Canceled
+	} else if parsed, err := parseGRPCCode(e.Code); err == nil {
+		code = parsed
+	}
+
+	sta := &spb.Status{
+		Code:    int32(code),
+		Message: e.Message,
+	}
+	for _, detail := range e.Details {
+		value, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(detail.Value, "="))
+		if err != nil {
+			continue
+		}
+		sta.Details = append(sta.Details, &anypb.Any{
+			TypeUrl: "type.googleapis.com/" + detail.Type,
+			Value:   value,
+		})
+	}
+	return status.FromProto(sta).Err()
+}
+
+func parseConnectError(statusCode int, body []byte) error {
+	connectErr := &connectError{}
+	if err := json.Unmarshal(body, connectErr); err != nil || connectErr.Code == "" {
+		return status.Error(codeFromHTTPStatus(statusCode), strings.TrimSpace(string(body)))
+	}
+	return connectErr.status(codeFromHTTPStatus(statusCode))
+}
+
+// statusFromTrailer parses the gRPC status from the trailers of gRPC-Web
+func statusFromTrailer(header http.Header) error {
+	if details := header.Get("Grpc-Status-Details-Bin"); details != "" {
+		if data, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(details, "=")); err == nil {
+			sta := &spb.Status{}
+			if err = proto.Unmarshal(data, sta); err == nil {
+				return status.FromProto(sta).Err()
+			}
+		}
+	}
+
+	code, err := strconv.Atoi(header.Get("Grpc-Status"))
+	if err != nil {
+		return status.Errorf(codes.Internal, "invalid grpc-status %q", header.Get("Grpc-Status"))
+	}
+	message, _ := url.PathUnescape(header.Get("Grpc-Message"))
+	return status.Error(codes.Code(code), message)
+}
+
+// codeFromHTTPStatus maps the HTTP status to the gRPC code when the server does not give one
+func codeFromHTTPStatus(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return codes.Internal
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.Unimplemented
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return codes.Unavailable
+	default:
+		return codes.Unknown
+	}
+}
+
+func writeEnvelope(writer io.Writer, flag byte, data []byte) {
+	prefix := make([]byte, 5)
+	prefix[0] = flag
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(data)))
+	_, _ = writer.Write(prefix)
+	_, _ = writer.Write(data)
+}
+
+func readEnvelope(reader io.Reader) (flag byte, data []byte, err error) {
+	prefix := make([]byte, 5)
+	if _, err = io.ReadFull(reader, prefix); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			err = fmt.Errorf("incomplete message prefix")
+		}
+		return
+	}
+
+	flag = prefix[0]
+	size := binary.BigEndian.Uint32(prefix[1:])
+	if size > maxHTTPRPCMessageSize {
+		err = status.Errorf(codes.ResourceExhausted, "received message larger than max (%d vs. %d)", size, maxHTTPRPCMessageSize)
+		return
+	}
+	data = make([]byte, size)
+	if _, err = io.ReadFull(reader, data); errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		err = fmt.Errorf("incomplete message, expect %d bytes", len(data))
+	}
+	return
+}
+
+func metadataCallOptions(opts []grpc.CallOption) (header, trailer *metadata.MD) {
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case grpc.HeaderCallOption:
+			header = o.HeaderAddr
+		case grpc.TrailerCallOption:
+			trailer = o.TrailerAddr
+		}
+	}
+	return
+}
+
+func setMetadata(addr *metadata.MD, md metadata.MD) {
+	if addr != nil {
+		*addr = md
+	}
+}
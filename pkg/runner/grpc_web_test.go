@@ -0,0 +1,312 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	testsrv "github.com/linuxsuren/api-testing/pkg/runner/grpc_test"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGRPCWebProtocols(t *testing.T) {
+	s := grpc.NewServer()
+	testsrv.RegisterMainServer(s, &testsrv.TestServer{})
+	reflection.RegisterV1(s)
+	l := runServer(t, s)
+	defer s.Stop()
+
+	for _, protocol := range []string{grpcProtocolWeb, grpcProtocolConnect} {
+		t.Run(protocol, func(t *testing.T) {
+			gateway := newWebGateway(t, l.Addr().String())
+			defer gateway.Close()
+
+			doGRPCTest(t, gateway.Listener, nil, &atest.RPCDesc{
+				ImportPath: []string{"grpc_test"},
+				ProtoFile:  "test.proto",
+				Protocol:   protocol,
+			}, testUnit{
+				name: "status with details",
+				testCase: &atest.TestCase{
+					Request: atest.Request{
+						API:    unary,
+						Header: map[string]string{"status": "missing"},
+						Body:   atest.NewRequestBody("{}"),
+					},
+					Expect: atest.Response{
+						GRPCCode: "NOT_FOUND",
+						Verify: []string{
+							`code == "NOT_FOUND"`,
+							`message == "missing"`,
+							`details[0]["@type"] == "type.googleapis.com/google.rpc.BadRequest"`,
+							`details[0].fieldViolations[0].field == "name"`,
+							`header["x-request-id"] == "1"`,
+							`trailer["x-trace-id"] == "2"`,
+						},
+					},
+				},
+				verify: func(t *testing.T, output any, err error) {
+					assert.NoError(t, err)
+				},
+			})
+
+			doGRPCTest(t, gateway.Listener, nil, &atest.RPCDesc{
+				ServerReflection: true,
+				Protocol:         protocol,
+			})
+
+			runner := NewGRPCTestCaseRunner(gateway.Listener.Addr().String(), atest.RPCDesc{
+				ImportPath: []string{"grpc_test"},
+				ProtoFile:  "test.proto",
+				Protocol:   protocol,
+			})
+			_, err := runner.RunTestCase(&atest.TestCase{
+				Request: atest.Request{API: gateway.Listener.Addr().String() + bidStream},
+				Stream: []atest.StreamStep{
+					{Send: `{"MsgID": 1}`},
+					{Expect: `{"MsgID": 1}`},
+					{CloseSend: true},
+				},
+			}, nil, context.TODO())
+			assert.ErrorContains(t, err, "step 2 expect: the messages are received after closeSend")
+		})
+	}
+}
+
+// newWebGateway translates the gRPC-Web and Connect requests to the native gRPC server
+func newWebGateway(t *testing.T, target string) *httptest.Server {
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType := r.Header.Get("Content-Type")
+		body, _ := io.ReadAll(r.Body)
+
+		var requests [][]byte
+		if contentType == "application/proto" {
+			requests = append(requests, body)
+		} else {
+			reader := bytes.NewReader(body)
+			for reader.Len() > 0 {
+				_, data, err := readEnvelope(reader)
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				requests = append(requests, data)
+			}
+		}
+
+		md := metadata.MD{}
+		for _, key := range []string{"message", "status"} {
+			if value := r.Header.Get(key); value != "" {
+				md.Set(key, value)
+			}
+		}
+
+		var header, trailer metadata.MD
+		responses, callErr := forwardWebRequest(metadata.NewOutgoingContext(r.Context(), md), conn, r.URL.Path, requests, &header, &trailer)
+		sta := status.Convert(callErr)
+		for key, values := range header {
+			w.Header()[key] = values
+		}
+
+		switch contentType {
+		case "application/grpc-web+proto":
+			w.Header().Set("Content-Type", contentType)
+			for _, data := range responses {
+				writeEnvelope(w, 0, data)
+			}
+
+			var trailers strings.Builder
+			fmt.Fprintf(&trailers, "grpc-status: %d\r\ngrpc-message: %s\r\n", sta.Code(), sta.Message())
+			if len(sta.Details()) > 0 {
+				data, _ := proto.Marshal(sta.Proto())
+				fmt.Fprintf(&trailers, "grpc-status-details-bin: %s\r\n", base64.RawStdEncoding.EncodeToString(data))
+			}
+			for key, values := range trailer {
+				if !strings.HasPrefix(key, "grpc-") {
+					fmt.Fprintf(&trailers, "%s: %s\r\n", key, strings.Join(values, ","))
+				}
+			}
+			writeEnvelope(w, envelopeFlagTrailer, []byte(trailers.String()))
+		case "application/proto":
+			for key, values := range trailer {
+				if !strings.HasPrefix(key, "grpc-") {
+					w.Header()["Trailer-"+key] = values
+				}
+			}
+			if sta.Code() != codes.OK {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(connectErrorOf(sta))
+				return
+			}
+			w.Header().Set("Content-Type", contentType)
+			_, _ = w.Write(responses[0])
+		default:
+			w.Header().Set("Content-Type", contentType)
+			for _, data := range responses {
+				writeEnvelope(w, 0, data)
+			}
+
+			delete(trailer, "grpc-status-details-bin")
+			end := map[string]any{"metadata": trailer}
+			if sta.Code() != codes.OK {
+				end["error"] = connectErrorOf(sta)
+			}
+			data, _ := json.Marshal(end)
+			writeEnvelope(w, envelopeFlagEndStream, data)
+		}
+	}))
+}
+
+func forwardWebRequest(ctx context.Context, conn *grpc.ClientConn, method string, requests [][]byte,
+	header, trailer *metadata.MD) (responses [][]byte, err error) {
+	var stream grpc.ClientStream
+	if stream, err = conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true, ClientStreams: true}, method,
+		grpc.ForceCodec(rawCodec{}), grpc.Header(header), grpc.Trailer(trailer)); err != nil {
+		return
+	}
+	for _, data := range requests {
+		if err = stream.SendMsg(data); err != nil {
+			return
+		}
+	}
+	if err = stream.CloseSend(); err != nil {
+		return
+	}
+	for {
+		var data []byte
+		if err = stream.RecvMsg(&data); err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			return
+		}
+		responses = append(responses, data)
+	}
+}
+
+func connectErrorOf(sta *status.Status) map[string]any {
+	details := []map[string]string{}
+	for _, detail := range sta.Proto().GetDetails() {
+		details = append(details, map[string]string{
+			"type":  strings.TrimPrefix(detail.GetTypeUrl(), "type.googleapis.com/"),
+			"value": base64.RawStdEncoding.EncodeToString(detail.GetValue()),
+		})
+	}
+	return map[string]any{
+		"code":    strings.ToLower(grpcCodeName(sta.Code())),
+		"message": sta.Message(),
+		"details": details,
+	}
+}
+
+// rawCodec passes the encoded messages through
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v any) ([]byte, error) {
+	return v.([]byte), nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v any) error {
+	*(v.(*[]byte)) = append([]byte(nil), data...)
+	return nil
+}
+
+func (rawCodec) Name() string {
+	return "proto"
+}
+
+func TestHTTPRPCHelpers(t *testing.T) {
+	t.Run("envelope", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		writeEnvelope(buf, envelopeFlagTrailer, []byte("data"))
+
+		flag, data, err := readEnvelope(buf)
+		assert.NoError(t, err)
+		assert.Equal(t, byte(envelopeFlagTrailer), flag)
+		assert.Equal(t, "data", string(data))
+
+		_, _, err = readEnvelope(buf)
+		assert.Equal(t, io.EOF, err)
+
+		_, _, err = readEnvelope(bytes.NewReader([]byte{0, 0, 0, 0, 4, 1}))
+		assert.Error(t, err)
+
+		// the size is checked before allocating
+		_, _, err = readEnvelope(bytes.NewReader([]byte{0, 0xff, 0xff, 0xff, 0xff}))
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("large unary response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write(make([]byte, maxHTTPRPCMessageSize+1))
+		}))
+		defer server.Close()
+
+		conn, err := newHTTPRPCConn(grpcProtocolConnect, server.Listener.Addr().String(), nil, nil)
+		assert.NoError(t, err)
+		err = conn.Invoke(context.TODO(), unary, &testsrv.Empty{}, &testsrv.HelloReply{})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("trailer status", func(t *testing.T) {
+		err := statusFromTrailer(http.Header{
+			"Grpc-Status":  []string{"5"},
+			"Grpc-Message": []string{"not%20found"},
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, "not found", status.Convert(err).Message())
+
+		err = statusFromTrailer(http.Header{"Grpc-Status": []string{"fake"}})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("connect error", func(t *testing.T) {
+		err := parseConnectError(http.StatusConflict, []byte(`{"code":"already_exists","message":"exists"}`))
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		err = parseConnectError(http.StatusRequestTimeout, []byte(`{"code":"canceled"}`))
+		assert.Equal(t, codes.Canceled, status.Code(err))
+
+		err = parseConnectError(http.StatusServiceUnavailable, []byte("bad gateway"))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, "bad gateway", status.Convert(err).Message())
+	})
+}
@@ -112,6 +112,11 @@ func (c ContextKey) ParentDir() ContextKey {
 	return ContextKey("parentDir")
 }
 
+// RequestRendered returns the key which marks the request as rendered
+func (c ContextKey) RequestRendered() ContextKey {
+	return ContextKey("requestRendered")
+}
+
 // GetContextValueOrEmpty returns the value of the context key, if not exist, return empty string
 func (c ContextKey) GetContextValueOrEmpty(ctx context.Context) string {
 	if ctx.Value(c) != nil {
@@ -136,7 +141,7 @@ func (r *simpleTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataConte
 
 	defer func() {
 		if err == nil {
-			err = runJob(testcase.After, dataContext, output)
+			err = runJob(ctx, testcase.After, dataContext, output)
 		}
 	}()
 
@@ -146,8 +151,10 @@ func (r *simpleTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataConte
 	}
 	client := util.TlsAwareHTTPClient(insecure) // TODO should have a way to change it
 	contextDir := NewContextKeyBuilder().ParentDir().GetContextValueOrEmpty(ctx)
-	if err = testcase.Request.Render(dataContext, contextDir); err != nil {
-		return
+	if rendered, _ := ctx.Value(NewContextKeyBuilder().RequestRendered()).(bool); !rendered {
+		if err = testcase.Request.Render(dataContext, contextDir); err != nil {
+			return
+		}
 	}
 
 	// add proxy setting
@@ -194,7 +201,7 @@ func (r *simpleTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataConte
 		request.Header.Add(key, val)
 	}
 
-	if err = runJob(testcase.Before, dataContext, nil); err != nil {
+	if err = runJob(ctx, testcase.Before, dataContext, nil); err != nil {
 		return
 	}
 
@@ -238,8 +245,8 @@ func (r *simpleTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataConte
 	respType := util.GetFirstHeaderValue(resp.Header, util.ContentType)
 
 	r.withSimpleResponseRecord(resp)
+	var responseBodyData []byte
 	if isNonBinaryContent(respType) {
-		var responseBodyData []byte
 		var rErr error
 		if responseBodyData, rErr = r.withResponseBodyRecord(resp); rErr != nil {
 			err = errors.Join(err, rErr)
@@ -267,6 +274,10 @@ func (r *simpleTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataConte
 		r.log.Debug("skip to read the body due to it is not struct content: %q\n", respType)
 	}
 
+	if err == nil {
+		err = captureVariables(testcase, resp, responseBodyData, dataContext)
+	}
+
 	r.cookies = append(r.cookies, resp.Cookies()...)
 	return
 }
@@ -457,24 +468,29 @@ func verifyResponseBodyData(caseName string, expect testing.Response, responseTy
 	return
 }
 
-func runJob(job *testing.Job, ctx interface{}, current interface{}) (err error) {
+func runJob(ctx context.Context, job *testing.Job, dataContext interface{}, current interface{}) (err error) {
 	if job == nil {
 		return
 	}
 	var program *vm.Program
 	env := map[string]interface{}{
-		"ctx":     ctx,
+		"ctx":     dataContext,
 		"current": current,
 	}
+	// the SQL statements of the jobs share the databases of the run
+	sqlFunc := func(params ...interface{}) (interface{}, error) {
+		return runSQLFunc(ctx, params...)
+	}
 
 	for _, item := range job.Items {
 		var exprText string
-		if exprText, err = render.Render("job", item, ctx); err != nil {
+		if exprText, err = render.Render("job", item, dataContext); err != nil {
 			err = fmt.Errorf("failed to render: %q, error is: %v", item, err)
 			break
 		}
 
-		if program, err = expr.Compile(exprText, expr.Env(env)); err != nil {
+		if program, err = expr.Compile(exprText, expr.Env(env),
+			expr.DisableBuiltin("sql"), expr.Function("sql", sqlFunc)); err != nil {
 			fmt.Printf("failed to compile: %q, %v\n", exprText, err)
 			return
 		}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/go-openapi/spec"
 	"github.com/h2non/gock"
+	"github.com/linuxsuren/api-testing/pkg/render"
 	atest "github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/linuxsuren/api-testing/pkg/util"
 	fakeruntime "github.com/linuxsuren/go-fake-runtime"
@@ -430,6 +431,13 @@ func TestLevelWriter(t *testing.T) {
 			assert.Equal(t, tt.expect, tt.buf.String())
 		}
 	}
+
+	t.Run("mask the secret values", func(t *testing.T) {
+		render.AddSecretValue("level-writer-secret")
+		buf := new(bytes.Buffer)
+		NewDefaultLevelWriter("info", buf).Info("token: %s\n", "level-writer-secret")
+		assert.Equal(t, "token: ******\n", buf.String())
+	})
 }
 
 func TestJSONSchemaValidation(t *testing.T) {
@@ -481,7 +489,7 @@ func TestRunJob(t *testing.T) {
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := runJob(&tt.job, nil, nil)
+			err := runJob(context.Background(), &tt.job, nil, nil)
 			assert.Equal(t, tt.hasErr, err != nil, err)
 		})
 	}
@@ -19,6 +19,8 @@ package runner
 import (
 	"fmt"
 	"io"
+
+	"github.com/linuxsuren/api-testing/pkg/render"
 )
 
 // LevelWriter represents a writer with level
@@ -39,10 +41,10 @@ type defaultLevelWriter struct {
 	FormatPrinter
 }
 
-// NewDefaultLevelWriter creates a default LevelWriter instance
+// NewDefaultLevelWriter creates a default LevelWriter instance, the secret values will be masked
 func NewDefaultLevelWriter(level string, writer io.Writer) LevelWriter {
 	result := &defaultLevelWriter{
-		Writer: writer,
+		Writer: render.NewMaskWriter(writer),
 	}
 	switch level {
 	case "trace":
@@ -0,0 +1,342 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// messageBroker is the client of a message broker, such as: MQTT and AMQP
+type messageBroker interface {
+	// subscribe sends the received messages to the channel until the context is done
+	subscribe(ctx context.Context, subscription *messageSubscription, messages chan<- *BrokerMessage) error
+	publish(ctx context.Context, publication *messagePublication) error
+	close() error
+}
+
+// messageBrokerDialer connects to the broker
+type messageBrokerDialer func(ctx context.Context, address string, tlsConfig *tls.Config) (messageBroker, error)
+
+// BrokerMessage is a received message
+type BrokerMessage struct {
+	// Topic is the MQTT topic, or the routing key of AMQP
+	Topic    string `json:"topic"`
+	Exchange string `json:"exchange,omitempty"`
+	// Payload is the JSON value if the payload is a valid JSON, or it is a string
+	Payload any            `json:"payload"`
+	Text    string         `json:"text"`
+	Headers map[string]any `json:"headers,omitempty"`
+
+	// settle acknowledges the expected message, or returns the message to the broker
+	settle func(matched bool) error
+}
+
+func newBrokerMessage(topic string, payload []byte) *BrokerMessage {
+	message := &BrokerMessage{
+		Topic:   topic,
+		Payload: string(payload),
+		Text:    string(payload),
+	}
+
+	var value any
+	if err := json.Unmarshal(payload, &value); err == nil {
+		message.Payload = value
+	}
+	return message
+}
+
+func (m *BrokerMessage) ack(matched bool) error {
+	if m.settle == nil {
+		return nil
+	}
+	return m.settle(matched)
+}
+
+// messagingRequest is the request body, the subscription is started before publishing the message
+type messagingRequest struct {
+	Publish   *messagePublication  `json:"publish"`
+	Subscribe *messageSubscription `json:"subscribe"`
+}
+
+type messagePublication struct {
+	// Topic is the MQTT topic, or the routing key of AMQP
+	Topic    string `json:"topic"`
+	Exchange string `json:"exchange"`
+	// Payload is sent as it is if it is a string, or it is sent as a JSON
+	Payload     json.RawMessage `json:"payload"`
+	QoS         byte            `json:"qos"`
+	Retain      bool            `json:"retain"`
+	ContentType string          `json:"contentType"`
+	Headers     map[string]any  `json:"headers"`
+}
+
+func (p *messagePublication) payload() []byte {
+	var text string
+	if err := json.Unmarshal(p.Payload, &text); err == nil {
+		return []byte(text)
+	}
+	return p.Payload
+}
+
+type messageSubscription struct {
+	// Topic is the MQTT topic filter, or the binding key of AMQP
+	Topic    string `json:"topic"`
+	Exchange string `json:"exchange"`
+	// Queue is an existing AMQP queue, the messages are kept by the broker before the test case
+	Queue string `json:"queue"`
+	QoS   byte   `json:"qos"`
+	// Count is the number of the expected messages, default is 1
+	Count int `json:"count"`
+	// Condition is an expr which filters the messages, such as: payload.status == "done"
+	Condition string `json:"condition"`
+	Timeout   string `json:"timeout"`
+
+	timeout time.Duration
+}
+
+func parseMessagingRequest(body string) (request *messagingRequest, err error) {
+	request = &messagingRequest{}
+	if err = json.Unmarshal([]byte(body), request); err != nil {
+		err = fmt.Errorf("invalid messaging request body: %v", err)
+		return
+	}
+
+	if request.Publish == nil && request.Subscribe == nil {
+		err = errors.New("publish or subscribe is required")
+		return
+	}
+	if subscription := request.Subscribe; subscription != nil {
+		if subscription.Count <= 0 {
+			subscription.Count = 1
+		}
+		subscription.timeout = defaultStreamStepTimeout
+		if subscription.Timeout != "" {
+			if subscription.timeout, err = time.ParseDuration(subscription.Timeout); err != nil {
+				err = fmt.Errorf("invalid timeout: %v", err)
+			}
+		}
+	}
+	return
+}
+
+type messagingTestCaseRunner struct {
+	UnimplementedRunner
+	// kind is mqtt or amqp
+	kind string
+	// address is the URL of the broker, it is overridden by the api of the request
+	address string
+	dial    messageBrokerDialer
+}
+
+// NewMessagingTestCaseRunner creates a runner which publishes and subscribes the messages of MQTT or AMQP 0-9-1
+func NewMessagingTestCaseRunner(kind, address string) TestCaseRunner {
+	runner := &messagingTestCaseRunner{
+		UnimplementedRunner: NewDefaultUnimplementedRunner(),
+		kind:                kind,
+		address:             address,
+		dial:                dialMQTT,
+	}
+	if kind == "amqp" {
+		runner.dial = dialAMQP
+	}
+	return runner
+}
+
+func init() {
+	for _, kind := range []string{"mqtt", "amqp"} {
+		RegisterRunner(kind, func(suite *testing.TestSuite) TestCaseRunner {
+			return NewMessagingTestCaseRunner(kind, suite.API)
+		})
+	}
+}
+
+// RunTestCase subscribes, runs the before job, publishes the message, then waits for the expected messages.
+// So that the before job could trigger the system to send the messages. The received messages are the output.
+func (r *messagingTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataContext any, ctx context.Context) (output any, err error) {
+	r.log.Info("start to run: '%s'\n", testcase.Name)
+	address := r.address
+	record := NewReportRecord()
+	defer func(rr *ReportRecord) {
+		rr.EndTime = time.Now()
+		rr.Error = err
+		rr.Group = testcase.Group
+		rr.Name = testcase.Name
+		rr.API = redactDSN(address)
+		rr.Method = strings.ToUpper(r.kind)
+		r.testReporter.PutRecord(rr)
+	}(record)
+
+	contextDir := NewContextKeyBuilder().ParentDir().GetContextValueOrEmpty(ctx)
+	if err = testcase.Request.Render(dataContext, contextDir); err != nil {
+		return
+	}
+	if address = util.EmptyThenDefault(testcase.Request.API, r.address); address == "" {
+		err = errors.New("the address of the broker is required, it could be the api of the test suite or the request")
+		return
+	}
+
+	var request *messagingRequest
+	if request, err = parseMessagingRequest(testcase.Request.Body.String()); err != nil {
+		return
+	}
+
+	var tlsConfig *tls.Config
+	if tlsConfig, err = r.tlsConfig(); err != nil {
+		return
+	}
+
+	var broker messageBroker
+	if broker, err = r.dial(ctx, address, tlsConfig); err != nil {
+		err = fmt.Errorf("failed to connect to the broker: %v", err)
+		return
+	}
+	defer broker.close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var messages chan *BrokerMessage
+	if request.Subscribe != nil {
+		messages = make(chan *BrokerMessage, 64)
+		if err = broker.subscribe(ctx, request.Subscribe, messages); err != nil {
+			err = fmt.Errorf("failed to subscribe: %v", err)
+			return
+		}
+	}
+
+	if err = runJob(ctx, testcase.Before, dataContext, nil); err != nil {
+		return
+	}
+
+	if request.Publish != nil {
+		r.log.Info("start to publish message to %q\n", request.Publish.Topic)
+		if err = broker.publish(ctx, request.Publish); err != nil {
+			err = fmt.Errorf("failed to publish: %v", err)
+			return
+		}
+	}
+
+	received := []*BrokerMessage{}
+	if request.Subscribe != nil {
+		if received, err = waitMessages(ctx, request.Subscribe, messages); err != nil {
+			return
+		}
+	}
+
+	var data []byte
+	if data, err = json.Marshal(received); err != nil {
+		return
+	}
+	record.Body = string(data)
+
+	if err = testcase.Expect.Render(dataContext); err != nil {
+		return
+	}
+	if output, err = verifyResponseBodyData(testcase.Name, testcase.Expect, util.JSON, data); err == nil {
+		err = jsonSchemaValidation(testcase.Expect.Schema, data)
+	}
+	if err == nil {
+		err = captureVariables(testcase, &http.Response{Header: http.Header{}}, data, dataContext)
+	}
+	if err == nil {
+		err = runJob(ctx, testcase.After, dataContext, output)
+	}
+	return
+}
+
+// waitMessages receives the messages which match the condition until the count is reached.
+// The unmatched messages are returned to the broker once the waiting is finished, so that
+// they are not received again during the waiting.
+func waitMessages(ctx context.Context, subscription *messageSubscription, messages <-chan *BrokerMessage) (
+	received []*BrokerMessage, err error) {
+	received = []*BrokerMessage{}
+	timer := time.NewTimer(subscription.timeout)
+	defer timer.Stop()
+
+	var unmatched []*BrokerMessage
+	defer func() {
+		for _, message := range unmatched {
+			if settleErr := message.ack(false); settleErr != nil && err == nil {
+				err = fmt.Errorf("failed to return the message: %v", settleErr)
+			}
+		}
+	}()
+
+	// the condition might fail on some messages, such as: the payload is not a JSON
+	var conditionErr error
+	for len(received) < subscription.Count {
+		select {
+		case message := <-messages:
+			if subscription.Condition != "" {
+				var ok bool
+				if ok, conditionErr = matchMessage(subscription.Condition, message); !ok {
+					unmatched = append(unmatched, message)
+					continue
+				}
+			}
+			if err = message.ack(true); err != nil {
+				err = fmt.Errorf("failed to acknowledge the message: %v", err)
+				return
+			}
+			received = append(received, message)
+		case <-timer.C:
+			err = fmt.Errorf("received %d of %d messages in %v", len(received), subscription.Count, subscription.timeout)
+			if conditionErr != nil {
+				err = fmt.Errorf("%v, condition error: %v", err, conditionErr)
+			}
+			return
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		}
+	}
+	return
+}
+
+// matchMessage checks the message with the expr, the fields of the message are the variables
+func matchMessage(condition string, message *BrokerMessage) (ok bool, err error) {
+	var data []byte
+	if data, err = json.Marshal(message); err != nil {
+		return
+	}
+
+	env := map[string]any{}
+	if err = json.Unmarshal(data, &env); err == nil {
+		ok, err = verify(condition, env)
+	}
+	return
+}
+
+func (r *messagingTestCaseRunner) tlsConfig() (config *tls.Config, err error) {
+	switch {
+	case r.Secure == nil:
+	case r.Secure.Insecure:
+		config = &tls.Config{InsecureSkipVerify: true}
+	default:
+		config, err = buildTLSConfig(r.Secure)
+	}
+	return
+}
@@ -0,0 +1,496 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestAMQPBroker(t *testing.T) {
+	address := newAMQPTestBroker(t)
+
+	suite := &atest.TestSuite{}
+	err := yaml.Unmarshal([]byte(`
+name: orders
+api: amqp://guest:guest@`+address+`/
+spec:
+  kind: amqp
+items:
+- name: created
+  request:
+    body:
+      subscribe:
+        exchange: orders
+        topic: order.*
+        condition: payload.id == {{.param.id}}
+        timeout: 3s
+      publish:
+        exchange: orders
+        topic: order.created
+        payload:
+          id: 1
+        headers:
+          source: api-testing
+  expect:
+    verify:
+    - len(data) == 1
+    - data[0].exchange == "orders"
+    - data[0].topic == "order.created"
+    - data[0].headers.source == "api-testing"
+- name: enqueue
+  request:
+    body:
+      publish:
+        topic: tasks
+        payload: hello
+  expect:
+    body: "[]"
+- name: dequeue
+  request:
+    body:
+      subscribe:
+        queue: tasks
+        timeout: 3s
+  expect:
+    bodyFieldsExpect:
+      0.text: hello
+`), suite)
+	assert.NoError(t, err)
+
+	runner := GetTestSuiteRunner(suite)
+	dataContext := map[string]interface{}{
+		atest.ContextKeyGlobalParam: map[string]string{"id": "1"},
+	}
+	for i := range suite.Items {
+		_, err = runner.RunTestCase(&suite.Items[i], dataContext, context.TODO())
+		assert.NoError(t, err, suite.Items[i].Name)
+	}
+
+	t.Run("unbound message", func(t *testing.T) {
+		_, err := runner.RunTestCase(&atest.TestCase{
+			Request: atest.Request{
+				Body: atest.NewRequestBody(`{"subscribe": {"exchange": "orders", "topic": "order.#", "timeout": "200ms"},
+"publish": {"exchange": "payments", "topic": "order.created", "payload": "paid"}}`),
+			},
+		}, dataContext, context.TODO())
+		assert.ErrorContains(t, err, "received 0 of 1 messages in 200ms")
+	})
+
+	t.Run("the unexpected messages are kept in the queue", func(t *testing.T) {
+		run := func(body string, verify ...string) error {
+			_, err := runner.RunTestCase(&atest.TestCase{
+				Request: atest.Request{Body: atest.NewRequestBody(body)},
+				Expect:  atest.Response{Verify: verify},
+			}, dataContext, context.TODO())
+			return err
+		}
+		for _, payload := range []string{"a", "b", "c"} {
+			assert.NoError(t, run(`{"publish": {"topic": "jobs", "payload": "`+payload+`"}}`))
+		}
+
+		assert.NoError(t, run(`{"subscribe": {"queue": "jobs", "condition": "text == \"b\"", "timeout": "1s"}}`,
+			`len(data) == 1`, `data[0].text == "b"`))
+		assert.NoError(t, run(`{"subscribe": {"queue": "jobs", "count": 2, "timeout": "1s"}}`,
+			`len(data) == 2`, `data[0].text + data[1].text in ["ac", "ca"]`))
+		assert.ErrorContains(t, run(`{"subscribe": {"queue": "jobs", "timeout": "200ms"}}`),
+			"received 0 of 1 messages in 200ms")
+	})
+
+	t.Run("no exchange or queue", func(t *testing.T) {
+		_, err := runner.RunTestCase(&atest.TestCase{
+			Request: atest.Request{Body: atest.NewRequestBody(`{"subscribe": {"topic": "order.created"}}`)},
+		}, dataContext, context.TODO())
+		assert.ErrorContains(t, err, "the exchange or the queue is required")
+	})
+}
+
+func TestAMQPTopicMatch(t *testing.T) {
+	assert.True(t, amqpTopicMatch("#", "order.created"))
+	assert.True(t, amqpTopicMatch("order.*", "order.created"))
+	assert.True(t, amqpTopicMatch("order.#", "order"))
+	assert.True(t, amqpTopicMatch("*.created", "order.created"))
+	assert.False(t, amqpTopicMatch("order.*", "order.created.v1"))
+	assert.False(t, amqpTopicMatch("order.*", "payment.created"))
+}
+
+type amqpTestMessage struct {
+	exchange, routingKey string
+	// header is the payload of the content header frame
+	header, body []byte
+	size         uint64
+}
+
+type amqpTestConn struct {
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func (c *amqpTestConn) write(frameType byte, channel uint16, payload []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	frame := binary.BigEndian.AppendUint16([]byte{frameType}, channel)
+	frame = binary.BigEndian.AppendUint32(frame, uint32(len(payload)))
+	_, _ = c.conn.Write(append(append(frame, payload...), 0xCE))
+}
+
+func (c *amqpTestConn) writeMethod(channel, classID, methodID uint16, fields ...any) {
+	c.write(1, channel, amqpMethod(classID, methodID, fields...))
+}
+
+type amqpTestConsumer struct {
+	conn    *amqpTestConn
+	channel uint16
+	tag     string
+	queue   string
+	noAck   bool
+	// unacked are the delivered messages which are not acknowledged, keyed by the delivery tag
+	unacked map[uint64]*amqpTestMessage
+}
+
+type amqpTestQueue struct {
+	messages  []*amqpTestMessage
+	consumers []*amqpTestConsumer
+}
+
+type amqpTestBinding struct {
+	queue, exchange, key string
+}
+
+// newAMQPTestBroker starts a minimal AMQP 0-9-1 broker, the exchanges route the messages by the topic patterns,
+// and the default exchange routes the messages to the queue whose name is the routing key.
+func newAMQPTestBroker(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	var mu sync.Mutex
+	var deliveryTag uint64
+	queues := map[string]*amqpTestQueue{}
+	var bindings []amqpTestBinding
+
+	getQueue := func(name string) *amqpTestQueue {
+		if queues[name] == nil {
+			queues[name] = &amqpTestQueue{}
+		}
+		return queues[name]
+	}
+	deliver := func(consumer *amqpTestConsumer, message *amqpTestMessage) {
+		deliveryTag++
+		if !consumer.noAck {
+			consumer.unacked[deliveryTag] = message
+		}
+		consumer.conn.writeMethod(consumer.channel, 60, 60, consumer.tag, deliveryTag, uint8(0),
+			message.exchange, message.routingKey)
+		consumer.conn.write(2, consumer.channel, message.header)
+		if len(message.body) > 0 {
+			consumer.conn.write(3, consumer.channel, message.body)
+		}
+	}
+	enqueue := func(queue *amqpTestQueue, message *amqpTestMessage) {
+		if len(queue.consumers) > 0 {
+			deliver(queue.consumers[0], message)
+		} else {
+			queue.messages = append(queue.messages, message)
+		}
+	}
+	// requeue returns the unacknowledged message to its queue
+	requeue := func(consumer *amqpTestConsumer, tag uint64) {
+		if message, ok := consumer.unacked[tag]; ok {
+			delete(consumer.unacked, tag)
+			enqueue(getQueue(consumer.queue), message)
+		}
+	}
+	route := func(message *amqpTestMessage) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		var targets []*amqpTestQueue
+		if message.exchange == "" {
+			targets = append(targets, getQueue(message.routingKey))
+		}
+		for _, binding := range bindings {
+			if binding.exchange == message.exchange && amqpTopicMatch(binding.key, message.routingKey) {
+				targets = append(targets, queues[binding.queue])
+			}
+		}
+		for _, queue := range targets {
+			enqueue(queue, message)
+		}
+	}
+	// settle acknowledges or requeues a message of the channel
+	settle := func(c *amqpTestConn, channel uint16, tag uint64, requeued bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, queue := range queues {
+			for _, consumer := range queue.consumers {
+				if _, ok := consumer.unacked[tag]; !ok || consumer.conn != c || consumer.channel != channel {
+					continue
+				}
+				if requeued {
+					requeue(consumer, tag)
+				} else {
+					delete(consumer.unacked, tag)
+				}
+				return
+			}
+		}
+	}
+	// removeConsumers returns the unacknowledged messages of the removed consumers to the queues
+	removeConsumers := func(match func(*amqpTestConsumer) bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		var removed []*amqpTestConsumer
+		for _, queue := range queues {
+			consumers := queue.consumers[:0]
+			for _, consumer := range queue.consumers {
+				if match(consumer) {
+					removed = append(removed, consumer)
+				} else {
+					consumers = append(consumers, consumer)
+				}
+			}
+			queue.consumers = consumers
+		}
+		for _, consumer := range removed {
+			tags := make([]uint64, 0, len(consumer.unacked))
+			for tag := range consumer.unacked {
+				tags = append(tags, tag)
+			}
+			slices.Sort(tags)
+			for _, tag := range tags {
+				requeue(consumer, tag)
+			}
+		}
+	}
+
+	serve := func(c *amqpTestConn) {
+		defer func() {
+			removeConsumers(func(consumer *amqpTestConsumer) bool {
+				return consumer.conn == c
+			})
+			_ = c.conn.Close()
+		}()
+
+		protocol := make([]byte, 8)
+		if _, err := io.ReadFull(c.conn, protocol); err != nil || string(protocol) != "AMQP\x00\x00\x09\x01" {
+			return
+		}
+		c.writeMethod(0, 10, 10, uint8(0), uint8(9), uint32(0), []byte("PLAIN"), []byte("en_US"))
+
+		publishing := map[uint16]*amqpTestMessage{}
+		for {
+			frameType, channel, payload, err := readAMQPFrame(c.conn)
+			if err != nil {
+				return
+			}
+
+			switch frameType {
+			case 2:
+				message := publishing[channel]
+				message.header, message.size = payload, binary.BigEndian.Uint64(payload[4:12])
+				if message.size == 0 {
+					route(message)
+				}
+				continue
+			case 3:
+				message := publishing[channel]
+				if message.body = append(message.body, payload...); uint64(len(message.body)) >= message.size {
+					route(message)
+				}
+				continue
+			case 1:
+			default:
+				continue
+			}
+
+			args := &amqpArgs{data: payload}
+			classID, methodID := args.short(), args.short()
+			switch {
+			case classID == 10 && methodID == 11: // connection.start-ok
+				c.writeMethod(0, 10, 30, uint16(2047), uint32(131072), uint16(0))
+			case classID == 10 && methodID == 40: // connection.open
+				c.writeMethod(0, 10, 41, "")
+			case classID == 10 && methodID == 50: // connection.close
+				c.writeMethod(0, 10, 51)
+				return
+			case classID == 20 && methodID == 10: // channel.open
+				c.writeMethod(channel, 20, 11, []byte{})
+			case classID == 20 && methodID == 40: // channel.close
+				removeConsumers(func(consumer *amqpTestConsumer) bool {
+					return consumer.conn == c && consumer.channel == channel
+				})
+				c.writeMethod(channel, 20, 41)
+			case classID == 50 && methodID == 10: // queue.declare
+				args.short()
+				name := args.shortstr()
+				mu.Lock()
+				if name == "" {
+					name = fmt.Sprintf("amq.gen-%d", len(queues))
+				}
+				getQueue(name)
+				mu.Unlock()
+				c.writeMethod(channel, 50, 11, name, uint32(0), uint32(0))
+			case classID == 50 && methodID == 20: // queue.bind
+				args.short()
+				binding := amqpTestBinding{queue: args.shortstr(), exchange: args.shortstr(), key: args.shortstr()}
+				mu.Lock()
+				bindings = append(bindings, binding)
+				mu.Unlock()
+				c.writeMethod(channel, 50, 21)
+			case classID == 60 && methodID == 20: // basic.consume
+				args.short()
+				name, tag := args.shortstr(), args.shortstr()
+				noAck := args.octet()&0x02 != 0
+				c.writeMethod(channel, 60, 21, tag)
+
+				mu.Lock()
+				queue := getQueue(name)
+				consumer := &amqpTestConsumer{conn: c, channel: channel, tag: tag, queue: name, noAck: noAck,
+					unacked: map[uint64]*amqpTestMessage{}}
+				queue.consumers = append(queue.consumers, consumer)
+				for _, message := range queue.messages {
+					deliver(consumer, message)
+				}
+				queue.messages = nil
+				mu.Unlock()
+			case classID == 60 && methodID == 30: // basic.cancel
+				tag := args.shortstr()
+				removeConsumers(func(consumer *amqpTestConsumer) bool {
+					return consumer.conn == c && consumer.tag == tag
+				})
+				c.writeMethod(channel, 60, 31, tag)
+			case classID == 60 && methodID == 80: // basic.ack
+				settle(c, channel, args.longlong(), false)
+			case classID == 60 && methodID == 120: // basic.nack
+				tag := args.longlong()
+				settle(c, channel, tag, args.octet()&0x02 != 0)
+			case classID == 60 && methodID == 40: // basic.publish
+				args.short()
+				publishing[channel] = &amqpTestMessage{exchange: args.shortstr(), routingKey: args.shortstr()}
+			}
+		}
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serve(&amqpTestConn{conn: conn})
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func readAMQPFrame(reader io.Reader) (frameType byte, channel uint16, payload []byte, err error) {
+	header := make([]byte, 7)
+	if _, err = io.ReadFull(reader, header); err != nil {
+		return
+	}
+	frameType, channel = header[0], binary.BigEndian.Uint16(header[1:3])
+	// the payload is followed by the frame end
+	payload = make([]byte, binary.BigEndian.Uint32(header[3:7])+1)
+	if _, err = io.ReadFull(reader, payload); err == nil {
+		payload = payload[:len(payload)-1]
+	}
+	return
+}
+
+// amqpMethod encodes the method frame payload, the strings are short strings, and the byte slices are long strings
+func amqpMethod(classID, methodID uint16, fields ...any) []byte {
+	data := binary.BigEndian.AppendUint16(nil, classID)
+	data = binary.BigEndian.AppendUint16(data, methodID)
+	for _, field := range fields {
+		switch value := field.(type) {
+		case uint8:
+			data = append(data, value)
+		case uint16:
+			data = binary.BigEndian.AppendUint16(data, value)
+		case uint32:
+			data = binary.BigEndian.AppendUint32(data, value)
+		case uint64:
+			data = binary.BigEndian.AppendUint64(data, value)
+		case string:
+			data = append(append(data, byte(len(value))), value...)
+		case []byte:
+			data = append(binary.BigEndian.AppendUint32(data, uint32(len(value))), value...)
+		}
+	}
+	return data
+}
+
+// amqpArgs decodes the leading fields of a method, the rest ones are ignored
+type amqpArgs struct {
+	data []byte
+}
+
+func (a *amqpArgs) short() (value uint16) {
+	value, a.data = binary.BigEndian.Uint16(a.data), a.data[2:]
+	return
+}
+
+func (a *amqpArgs) octet() (value uint8) {
+	value, a.data = a.data[0], a.data[1:]
+	return
+}
+
+func (a *amqpArgs) longlong() (value uint64) {
+	value, a.data = binary.BigEndian.Uint64(a.data), a.data[8:]
+	return
+}
+
+func (a *amqpArgs) shortstr() (value string) {
+	size := int(a.data[0])
+	value, a.data = string(a.data[1:1+size]), a.data[1+size:]
+	return
+}
+
+// amqpTopicMatch checks the routing key against the binding key of a topic exchange,
+// "*" matches exactly one word, and "#" matches zero or more words
+func amqpTopicMatch(pattern, key string) bool {
+	return amqpWordsMatch(strings.Split(pattern, "."), strings.Split(key, "."))
+}
+
+func amqpWordsMatch(pattern, words []string) bool {
+	if len(pattern) == 0 {
+		return len(words) == 0
+	}
+	if pattern[0] == "#" {
+		for i := range len(words) + 1 {
+			if amqpWordsMatch(pattern[1:], words[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	return len(words) > 0 && (pattern[0] == "*" || pattern[0] == words[0]) && amqpWordsMatch(pattern[1:], words[1:])
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// the milliseconds of waiting for the disconnection
+const mqttDisconnectQuiesce = 250
+
+type mqttBroker struct {
+	client mqtt.Client
+}
+
+// dialMQTT connects to the MQTT broker, such as: tcp://localhost:1883, ssl://localhost:8883 or ws://localhost:8083/mqtt.
+// The username and password could be given in the URL.
+func dialMQTT(ctx context.Context, address string, tlsConfig *tls.Config) (broker messageBroker, err error) {
+	options := mqtt.NewClientOptions().
+		AddBroker(address).
+		SetClientID(fmt.Sprintf("atest-%s", util.String(8))).
+		SetCleanSession(true).
+		SetAutoReconnect(false).
+		SetConnectTimeout(defaultStreamStepTimeout)
+	if tlsConfig != nil {
+		options.SetTLSConfig(tlsConfig)
+	}
+
+	client := mqtt.NewClient(options)
+	if err = waitMQTTToken(ctx, client.Connect()); err == nil {
+		broker = &mqttBroker{client: client}
+	}
+	return
+}
+
+func (b *mqttBroker) subscribe(ctx context.Context, subscription *messageSubscription, messages chan<- *BrokerMessage) error {
+	if subscription.Topic == "" {
+		return errors.New("the topic is required")
+	}
+
+	token := b.client.Subscribe(subscription.Topic, subscription.QoS, func(_ mqtt.Client, msg mqtt.Message) {
+		message := newBrokerMessage(msg.Topic(), msg.Payload())
+		select {
+		case messages <- message:
+		case <-ctx.Done():
+		}
+	})
+	return waitMQTTToken(ctx, token)
+}
+
+func (b *mqttBroker) publish(ctx context.Context, publication *messagePublication) error {
+	if publication.Topic == "" {
+		return errors.New("the topic is required")
+	}
+	return waitMQTTToken(ctx, b.client.Publish(publication.Topic, publication.QoS, publication.Retain, publication.payload()))
+}
+
+func (b *mqttBroker) close() error {
+	b.client.Disconnect(mqttDisconnectQuiesce)
+	return nil
+}
+
+func waitMQTTToken(ctx context.Context, token mqtt.Token) (err error) {
+	select {
+	case <-token.Done():
+		err = token.Error()
+	case <-time.After(defaultStreamStepTimeout):
+		err = fmt.Errorf("no response from the MQTT broker in %v", defaultStreamStepTimeout)
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	return
+}
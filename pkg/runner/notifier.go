@@ -0,0 +1,401 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/logging"
+	"github.com/linuxsuren/api-testing/pkg/render"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"gopkg.in/yaml.v3"
+)
+
+var notifierLogger = logging.DefaultLogger(logging.LogLevelInfo).WithName("notifier")
+
+// the conditions of a notification rule
+const (
+	NotifyWhenAlways   = "always"
+	NotifyWhenFailure  = "failure"
+	NotifyWhenRecovery = "recovery"
+)
+
+// the supported kinds of a notification rule
+const (
+	NotifierKindWebhook  = "webhook"
+	NotifierKindSlack    = "slack"
+	NotifierKindTeams    = "teams"
+	NotifierKindFeishu   = "feishu"
+	NotifierKindDingTalk = "dingtalk"
+)
+
+// NotificationConfig represents the notification rules of the test runs
+type NotificationConfig struct {
+	// Link is a template which points to the run history, such as: http://localhost:8080/api/v1/historyTestCase/{{.HistoryID}}
+	Link  string             `yaml:"link,omitempty"`
+	Rules []NotificationRule `yaml:"rules"`
+}
+
+// NotificationRule represents where and when to send the notification
+type NotificationRule struct {
+	Name string `yaml:"name"`
+	// When could be: always, failure, recovery. The default value is failure
+	When string `yaml:"when,omitempty"`
+	// Kind could be: webhook, slack, teams, feishu, dingtalk. The default value is webhook
+	Kind   string            `yaml:"kind,omitempty"`
+	URL    string            `yaml:"url"`
+	Header map[string]string `yaml:"header,omitempty"`
+	// Suites limits the test suites of this rule, all suites are included if it's empty
+	Suites []string `yaml:"suites,omitempty"`
+	// Template is used to render the message instead of the default one
+	Template string `yaml:"template,omitempty"`
+}
+
+// RunSummary is the summary of a test suite run
+type RunSummary struct {
+	Suite       string        `json:"suite"`
+	Success     bool          `json:"success"`
+	Recovered   bool          `json:"recovered"`
+	Total       int           `json:"total"`
+	FailedCases []FailedCase  `json:"failedCases"`
+	StartTime   time.Time     `json:"startTime"`
+	Duration    time.Duration `json:"duration"`
+	// HistoryID is the ID of the last history record of the run, it is the first failed one if the run is failed.
+	// It is empty if the results are not stored as history, such as: running via the command line
+	HistoryID string `json:"historyID,omitempty"`
+	Link      string `json:"link,omitempty"`
+}
+
+// FailedCase represents a failed test case
+type FailedCase struct {
+	Name  string `json:"name"`
+	Error string `json:"error"`
+	// HistoryID is the ID of the history record of this test case
+	HistoryID string `json:"historyID,omitempty"`
+	// Link is rendered from the link template with the HistoryID of this test case
+	Link string `json:"link,omitempty"`
+}
+
+// NewRunSummary creates a summary of a test suite run
+func NewRunSummary(suite string) *RunSummary {
+	return &RunSummary{
+		Suite:     suite,
+		Success:   true,
+		StartTime: time.Now(),
+	}
+}
+
+// AddCase adds the result of a test case into the summary, the historyID is empty if there is no history record
+func (s *RunSummary) AddCase(name, historyID string, err error) {
+	s.Total++
+	if historyID != "" && s.Success {
+		s.HistoryID = historyID
+	}
+	if err != nil {
+		s.Success = false
+		s.FailedCases = append(s.FailedCases, FailedCase{
+			Name:      name,
+			Error:     err.Error(),
+			HistoryID: historyID,
+		})
+	}
+}
+
+// masked returns a copy whose text fields have no secret values, the errors might contain the secrets,
+// such as: the failed assertions and the HTTP errors
+func (s *RunSummary) masked() *RunSummary {
+	result := *s
+	result.Suite = render.MaskSecrets(s.Suite)
+	result.Link = render.MaskSecrets(s.Link)
+	result.FailedCases = make([]FailedCase, len(s.FailedCases))
+	for i, item := range s.FailedCases {
+		result.FailedCases[i] = FailedCase{
+			Name:      render.MaskSecrets(item.Name),
+			Error:     render.MaskSecrets(item.Error),
+			HistoryID: item.HistoryID,
+			Link:      render.MaskSecrets(item.Link),
+		}
+	}
+	return &result
+}
+
+// Finish marks the summary as finished
+func (s *RunSummary) Finish(err error) {
+	s.Duration = time.Since(s.StartTime)
+	if err != nil && s.Success {
+		s.Success = false
+		s.FailedCases = append(s.FailedCases, FailedCase{
+			Error: err.Error(),
+		})
+	}
+}
+
+// Notifier sends the notifications of the test runs
+type Notifier interface {
+	Notify(summary *RunSummary) error
+	WithStateFile(stateFile string) Notifier
+}
+
+type notifier struct {
+	configFile string
+	stateFile  string
+	states     map[string]bool
+	client     *http.Client
+	mu         sync.Mutex
+}
+
+// NewNotifier creates a notifier which loads the rules from the config file.
+// It does nothing if the config file does not exist.
+func NewNotifier(configFile string) Notifier {
+	return &notifier{
+		configFile: configFile,
+		states:     make(map[string]bool),
+		client:     &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// LoadNotificationConfig loads the notification config from the file
+func LoadNotificationConfig(configFile string) (config *NotificationConfig, err error) {
+	config = &NotificationConfig{}
+	var data []byte
+	if data, err = os.ReadFile(configFile); err == nil {
+		if err = yaml.Unmarshal(data, config); err == nil {
+			err = config.Validate()
+		}
+	}
+	return
+}
+
+// Validate checks if the notification rules are valid
+func (c *NotificationConfig) Validate() (err error) {
+	for _, rule := range c.Rules {
+		switch rule.When {
+		case "", NotifyWhenAlways, NotifyWhenFailure, NotifyWhenRecovery:
+		default:
+			err = errors.Join(err, fmt.Errorf("rule %q has unsupported condition %q", rule.Name, rule.When))
+		}
+
+		switch rule.Kind {
+		case "", NotifierKindWebhook, NotifierKindSlack, NotifierKindTeams, NotifierKindFeishu, NotifierKindDingTalk:
+		default:
+			err = errors.Join(err, fmt.Errorf("rule %q has unsupported kind %q", rule.Name, rule.Kind))
+		}
+
+		if rule.URL == "" {
+			err = errors.Join(err, fmt.Errorf("rule %q has no url", rule.Name))
+		}
+	}
+	return
+}
+
+// WithStateFile persists the last status of the test suites into a file,
+// it's useful to detect the recovery across different processes
+func (n *notifier) WithStateFile(stateFile string) Notifier {
+	n.stateFile = stateFile
+	return n
+}
+
+// Notify sends the notifications which match the rules
+func (n *notifier) Notify(summary *RunSummary) (err error) {
+	if _, statErr := os.Stat(n.configFile); n.configFile == "" || statErr != nil {
+		return
+	}
+
+	var config *NotificationConfig
+	if config, err = LoadNotificationConfig(n.configFile); err != nil {
+		return
+	}
+
+	summary.Recovered = n.recordState(summary.Suite, summary.Success)
+	if config.Link != "" {
+		if summary.Link, err = render.Render("notification link", config.Link, summary); err != nil {
+			return
+		}
+
+		// each failed case links to its own history record
+		for i := range summary.FailedCases {
+			failedCase := &summary.FailedCases[i]
+			if failedCase.HistoryID == "" {
+				continue
+			}
+			caseSummary := *summary
+			caseSummary.HistoryID = failedCase.HistoryID
+			if failedCase.Link, err = render.Render("notification link", config.Link, &caseSummary); err != nil {
+				return
+			}
+		}
+	}
+
+	for _, rule := range config.Rules {
+		if !rule.match(summary) {
+			continue
+		}
+
+		if sendErr := n.send(rule, summary); sendErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to send notification %q: %w", rule.Name, sendErr))
+		} else {
+			notifierLogger.Info("notification was sent", "name", rule.Name, "suite", summary.Suite)
+		}
+	}
+	return
+}
+
+// recordState saves the latest status, returns true if the suite is recovered from failure
+func (n *notifier) recordState(suite string, success bool) (recovered bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.stateFile != "" {
+		if data, err := os.ReadFile(n.stateFile); err == nil {
+			_ = yaml.Unmarshal(data, &n.states)
+		}
+	}
+
+	last, ok := n.states[suite]
+	recovered = ok && !last && success
+	n.states[suite] = success
+
+	if n.stateFile != "" {
+		if data, err := yaml.Marshal(n.states); err == nil {
+			_ = os.MkdirAll(filepath.Dir(n.stateFile), 0755)
+			if err = os.WriteFile(n.stateFile, data, 0644); err != nil {
+				notifierLogger.Info("failed to save the notification state", "error", err)
+			}
+		}
+	}
+	return
+}
+
+func (r NotificationRule) match(summary *RunSummary) (ok bool) {
+	if len(r.Suites) > 0 {
+		for _, suite := range r.Suites {
+			if ok = suite == summary.Suite; ok {
+				break
+			}
+		}
+		if !ok {
+			return
+		}
+	}
+
+	switch r.When {
+	case NotifyWhenAlways:
+		ok = true
+	case NotifyWhenRecovery:
+		ok = summary.Recovered
+	default:
+		ok = !summary.Success
+	}
+	return
+}
+
+func (n *notifier) send(rule NotificationRule, summary *RunSummary) (err error) {
+	tpl := util.EmptyThenDefault(rule.Template, defaultNotificationTemplate)
+	summary = summary.masked()
+
+	var message string
+	if message, err = render.Render("notification", tpl, summary); err != nil {
+		return
+	}
+	message = render.MaskSecrets(message)
+
+	var payload []byte
+	if payload, err = buildNotificationPayload(rule.Kind, message, summary); err != nil {
+		return
+	}
+
+	var req *http.Request
+	if req, err = http.NewRequest(http.MethodPost, rule.URL, bytes.NewBuffer(payload)); err != nil {
+		return
+	}
+	req.Header.Set(util.ContentType, util.JSON)
+	for key, val := range rule.Header {
+		req.Header.Set(key, val)
+	}
+
+	var resp *http.Response
+	if resp, err = n.client.Do(req); err == nil {
+		defer resp.Body.Close()
+		if resp.StatusCode >= http.StatusBadRequest {
+			data, _ := io.ReadAll(resp.Body)
+			err = fmt.Errorf("unexpected status code %d, response: %s", resp.StatusCode, string(data))
+		}
+	}
+	return
+}
+
+func buildNotificationPayload(kind, message string, summary *RunSummary) ([]byte, error) {
+	var payload interface{}
+	switch kind {
+	case NotifierKindSlack:
+		payload = map[string]string{
+			"text": message,
+		}
+	case NotifierKindTeams:
+		payload = map[string]string{
+			"@type":    "MessageCard",
+			"@context": "https://schema.org/extensions",
+			"summary":  fmt.Sprintf("Test suite %s", summary.Suite),
+			"text":     message,
+		}
+	case NotifierKindFeishu:
+		payload = map[string]interface{}{
+			"msg_type": "text",
+			"content": map[string]string{
+				"text": message,
+			},
+		}
+	case NotifierKindDingTalk:
+		payload = map[string]interface{}{
+			"msgtype": "text",
+			"text": map[string]string{
+				"content": message,
+			},
+		}
+	default:
+		payload = struct {
+			*RunSummary
+			Message string `json:"message"`
+		}{
+			RunSummary: summary,
+			Message:    message,
+		}
+	}
+	return json.Marshal(payload)
+}
+
+const defaultNotificationTemplate = `{{- if .Success -}}
+{{- if .Recovered }}Test suite {{.Suite}} is recovered{{ else }}Test suite {{.Suite}} is passed{{ end -}}
+{{- else -}}
+Test suite {{.Suite}} is failed, {{len .FailedCases}} of {{.Total}} test cases failed
+{{- range .FailedCases }}
+- {{.Name}}: {{.Error}}{{ if .Link }} ({{.Link}}){{ end }}
+{{- end }}
+{{- end }}
+Duration: {{.Duration}}
+{{- if .Link }}
+History: {{.Link}}
+{{- end }}`
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/linuxsuren/api-testing/pkg/render"
+	"github.com/stretchr/testify/assert"
+)
+
+const notificationConfig = `link: http://localhost:8080/api/v1/historyTestCase/{{.HistoryID}}
+rules:
+- name: webhook
+  when: always
+  url: http://foo/webhook
+  header:
+    Authorization: token
+- name: slack
+  kind: slack
+  url: http://foo/slack
+- name: teams
+  kind: teams
+  url: http://foo/teams
+  suites: [other]
+- name: feishu
+  kind: feishu
+  when: recovery
+  url: http://foo/feishu
+- name: dingtalk
+  kind: dingtalk
+  when: recovery
+  url: http://foo/dingtalk
+  template: "{{.Suite}} is recovered"
+`
+
+func TestNotifier(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "notifications.yaml")
+	assert.NoError(t, os.WriteFile(configFile, []byte(notificationConfig), 0644))
+
+	t.Run("config file not exist", func(t *testing.T) {
+		assert.NoError(t, NewNotifier(filepath.Join(dir, "fake.yaml")).Notify(NewRunSummary("simple")))
+	})
+
+	notifier := NewNotifier(configFile).WithStateFile(filepath.Join(dir, "state", "notification-state.yaml"))
+
+	t.Run("failure", func(t *testing.T) {
+		defer gock.Off()
+		gock.New("http://foo").Post("/webhook").MatchHeader("Authorization", "token").
+			BodyString(`"failedCases":\[{"name":"get","error":"fake","historyID":"fake-id","link":"http://localhost:8080/api/v1/historyTestCase/fake-id"}\],.*"historyID":"fake-id"`).Reply(http.StatusOK)
+		gock.New("http://foo").Post("/slack").
+			BodyString(`{"text":"Test suite simple is failed, 1 of 2 test cases failed\\n- get: fake \(http://localhost:8080/api/v1/historyTestCase/fake-id\)\\nDuration: .*\\nHistory: http://localhost:8080/api/v1/historyTestCase/fake-id"}`).
+			Reply(http.StatusOK)
+
+		summary := NewRunSummary("simple")
+		summary.AddCase("get", "fake-id", errors.New("fake"))
+		summary.AddCase("query", "other-id", nil)
+		summary.Finish(nil)
+		assert.NoError(t, notifier.Notify(summary))
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("recovery", func(t *testing.T) {
+		defer gock.Off()
+		gock.New("http://foo").Post("/webhook").BodyString(`"recovered":true`).Reply(http.StatusOK)
+		gock.New("http://foo").Post("/feishu").
+			BodyString(`{"content":{"text":"Test suite simple is recovered.*"},"msg_type":"text"}`).Reply(http.StatusOK)
+		gock.New("http://foo").Post("/dingtalk").
+			BodyString(`{"msgtype":"text","text":{"content":"simple is recovered"}}`).Reply(http.StatusOK)
+
+		summary := NewRunSummary("simple")
+		summary.AddCase("get", "", nil)
+		summary.Finish(nil)
+		assert.NoError(t, notifier.Notify(summary))
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("state from file", func(t *testing.T) {
+		defer gock.Off()
+		gock.New("http://foo").Post("/webhook").BodyString(`"recovered":false`).Reply(http.StatusOK)
+
+		summary := NewRunSummary("simple")
+		summary.Finish(nil)
+		err := NewNotifier(configFile).WithStateFile(filepath.Join(dir, "state", "notification-state.yaml")).Notify(summary)
+		assert.NoError(t, err)
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("teams", func(t *testing.T) {
+		defer gock.Off()
+		gock.New("http://foo").Post("/webhook").Reply(http.StatusOK)
+		gock.New("http://foo").Post("/slack").Reply(http.StatusOK)
+		gock.New("http://foo").Post("/teams").
+			BodyString(`{"@context":"https://schema.org/extensions","@type":"MessageCard","summary":"Test suite other",.*}`).
+			Reply(http.StatusOK)
+
+		summary := NewRunSummary("other")
+		summary.Finish(errors.New("fake"))
+		assert.NoError(t, notifier.Notify(summary))
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("mask secrets", func(t *testing.T) {
+		render.AddSecretValue("notification-secret")
+		defer gock.Off()
+		gock.New("http://foo").Post("/webhook").
+			BodyString(`"failedCases":\[{"name":"get","error":"token \*\*\*\*\*\* is invalid"}\]`).Reply(http.StatusOK)
+		gock.New("http://foo").Post("/slack").BodyString(`- get: token \*\*\*\*\*\* is invalid`).Reply(http.StatusOK)
+
+		summary := NewRunSummary("simple")
+		summary.AddCase("get", "", errors.New("token notification-secret is invalid"))
+		summary.Finish(nil)
+		assert.NoError(t, notifier.Notify(summary))
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("failed to send", func(t *testing.T) {
+		defer gock.Off()
+		gock.New("http://foo").Post("/webhook").Reply(http.StatusBadRequest).BodyString("bad request")
+		gock.New("http://foo").Post("/slack").Reply(http.StatusOK)
+
+		summary := NewRunSummary("simple")
+		summary.AddCase("get", "", errors.New("fake"))
+		summary.Finish(nil)
+		err := notifier.Notify(summary)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "bad request")
+	})
+}
+
+func TestNotificationConfig(t *testing.T) {
+	_, err := LoadNotificationConfig("fake.yaml")
+	assert.Error(t, err)
+
+	config := &NotificationConfig{
+		Rules: []NotificationRule{{
+			Name: "invalid", When: "fake", Kind: "fake",
+		}},
+	}
+	err = config.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported condition")
+	assert.Contains(t, err.Error(), "unsupported kind")
+	assert.Contains(t, err.Error(), "has no url")
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"errors"
+)
+
+type runScopeKey struct{}
+
+// runScope holds the connections and the caches which are shared by the cases of a run
+type runScope struct {
+	grpcConns *grpcConnectionPool
+	sqlDBs    *sqlDatabasePool
+	// reflection keeps the descriptors from the server reflection, keyed by the target and the symbol
+	reflection *descriptorCache
+	// remoteProtos keeps the compiled proto files which are downloaded from an URL
+	remoteProtos *descriptorCache
+	// graphqlSchemas keeps the GraphQL schemas, keyed by the source or the introspection endpoint
+	graphqlSchemas *descriptorCache
+	// wsdl keeps the SOAP services, keyed by the source of the WSDL
+	wsdl *descriptorCache
+}
+
+func newRunScope() *runScope {
+	return &runScope{
+		grpcConns:      newGRPCConnectionPool(),
+		sqlDBs:         newSQLDatabasePool(),
+		reflection:     newDescriptorCache(),
+		remoteProtos:   newDescriptorCache(),
+		graphqlSchemas: newDescriptorCache(),
+		wsdl:           newDescriptorCache(),
+	}
+}
+
+// defaultRunScope is used when the context has no scope, such as the command line
+var defaultRunScope = &runScope{
+	grpcConns:      defaultGRPCConnectionPool,
+	sqlDBs:         defaultSQLDatabasePool,
+	reflection:     newDescriptorCache(),
+	remoteProtos:   newDescriptorCache(),
+	graphqlSchemas: newDescriptorCache(),
+	wsdl:           newDescriptorCache(),
+}
+
+// WithRunScope returns a context whose cases share the connections and the caches of a run,
+// they are released by the returned function. The scope of the parent context is reused if there is.
+func WithRunScope(ctx context.Context) (context.Context, func() error) {
+	if _, ok := ctx.Value(runScopeKey{}).(*runScope); ok {
+		return ctx, func() error { return nil }
+	}
+	scope := newRunScope()
+	return context.WithValue(ctx, runScopeKey{}, scope), scope.close
+}
+
+func getRunScope(ctx context.Context) *runScope {
+	if ctx != nil {
+		if scope, ok := ctx.Value(runScopeKey{}).(*runScope); ok {
+			return scope
+		}
+	}
+	return defaultRunScope
+}
+
+func (s *runScope) closeGRPC() error {
+	s.reflection.reset()
+	s.remoteProtos.reset()
+	return s.grpcConns.close()
+}
+
+func (s *runScope) close() error {
+	s.graphqlSchemas.reset()
+	s.wsdl.reset()
+	return errors.Join(s.closeGRPC(), s.sqlDBs.close())
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	testsrv "github.com/linuxsuren/api-testing/pkg/runner/grpc_test"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+func TestRunScope(t *testing.T) {
+	var reflectCount atomic.Int32
+	s := grpc.NewServer(grpc.StreamInterceptor(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		if strings.Contains(info.FullMethod, "ServerReflection") {
+			reflectCount.Add(1)
+		}
+		return handler(srv, ss)
+	}))
+	testsrv.RegisterMainServer(s, &testsrv.TestServer{})
+	reflection.RegisterV1(s)
+	l := runServer(t, s)
+	defer s.Stop()
+
+	run := func(ctx context.Context) {
+		runner := NewGRPCTestCaseRunner(l.Addr().String(), atest.RPCDesc{ServerReflection: true})
+		_, err := runner.RunTestCase(&atest.TestCase{
+			Request: atest.Request{
+				API:  l.Addr().String() + unary,
+				Body: atest.NewRequestBody("{}"),
+			},
+		}, nil, ctx)
+		assert.NoError(t, err)
+	}
+
+	ctx, closeScope := WithRunScope(context.Background())
+	nested, closeNested := WithRunScope(ctx)
+	assert.Same(t, getRunScope(ctx), getRunScope(nested), "the scope of the parent is reused")
+	assert.NotSame(t, defaultRunScope, getRunScope(ctx))
+	assert.NoError(t, closeNested())
+
+	run(ctx)
+	run(nested)
+	assert.Equal(t, int32(1), reflectCount.Load(), "the reflection result is cached in the run")
+	assert.Len(t, getRunScope(ctx).grpcConns.conns, 1)
+
+	assert.NoError(t, closeScope())
+	assert.Empty(t, getRunScope(ctx).grpcConns.conns)
+
+	ctx, closeScope = WithRunScope(context.Background())
+	defer closeScope()
+	run(ctx)
+	assert.Equal(t, int32(2), reflectCount.Load(), "the reflection is not cached across the runs")
+	assert.Same(t, defaultRunScope, getRunScope(context.Background()))
+}
@@ -22,6 +22,7 @@ import (
 	"io"
 	"strings"
 
+	"github.com/linuxsuren/api-testing/pkg/render"
 	"github.com/linuxsuren/api-testing/pkg/testing"
 	fakeruntime "github.com/linuxsuren/go-fake-runtime"
 )
@@ -91,9 +92,9 @@ func (r *UnimplementedRunner) RunTestCase(testcase *testing.TestCase, dataContex
 	return nil, fmt.Errorf("unimplemented")
 }
 
-// WithOutputWriter sets the io.Writer
+// WithOutputWriter sets the io.Writer, the secret values will be masked
 func (r *UnimplementedRunner) WithOutputWriter(writer io.Writer) {
-	r.writer = writer
+	r.writer = render.NewMaskWriter(writer)
 }
 
 // WithWriteLevel sets the level writer
@@ -0,0 +1,650 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/render"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// the namespaces of the SOAP envelope
+const (
+	soapEnvelope11 = "http://schemas.xmlsoap.org/soap/envelope/"
+	soapEnvelope12 = "http://www.w3.org/2003/05/soap-envelope"
+)
+
+const soapContentType12 = "application/soap+xml"
+
+type soapTestCaseRunner struct {
+	UnimplementedRunner
+	// wsdl is the local file or URL of the WSDL, the structured body requires it
+	wsdl            string
+	response        SimpleResponse
+	apiSuggestLimit int
+}
+
+// NewSOAPTestCaseRunner creates a runner which sends the SOAP 1.1 or 1.2 requests
+func NewSOAPTestCaseRunner(wsdl string) TestCaseRunner {
+	return &soapTestCaseRunner{
+		UnimplementedRunner: NewDefaultUnimplementedRunner(),
+		wsdl:                wsdl,
+		apiSuggestLimit:     10,
+	}
+}
+
+func init() {
+	RegisterRunner("soap", func(suite *testing.TestSuite) TestCaseRunner {
+		return NewSOAPTestCaseRunner(suite.Spec.URL)
+	})
+}
+
+func (r *soapTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataContext any, ctx context.Context) (output any, err error) {
+	r.log.Info("start to run: '%s'\n", testcase.Name)
+	record := NewReportRecord()
+	defer func(rr *ReportRecord) {
+		rr.EndTime = time.Now()
+		rr.Error = err
+		rr.Group = testcase.Group
+		rr.Name = testcase.Name
+		rr.API = testcase.Request.API
+		rr.Method = "SOAP"
+		r.testReporter.PutRecord(rr)
+	}(record)
+
+	defer func() {
+		if err == nil {
+			err = runJob(ctx, testcase.After, dataContext, output)
+		}
+	}()
+
+	contextDir := NewContextKeyBuilder().ParentDir().GetContextValueOrEmpty(ctx)
+	if err = testcase.Request.Render(dataContext, contextDir); err != nil {
+		return
+	}
+
+	client := util.TlsAwareHTTPClient(r.Secure != nil && r.Secure.Insecure)
+	var service *soapService
+	if r.wsdl != "" {
+		var wsdl string
+		if wsdl, err = render.Render("wsdl", r.wsdl, dataContext); err != nil {
+			return
+		}
+		if service, err = loadSOAPService(ctx, client, wsdl); err != nil {
+			return
+		}
+	}
+
+	var message *soapMessage
+	if message, err = newSOAPMessage(service, testcase.Request); err != nil {
+		return
+	}
+
+	var request *http.Request
+	if request, err = http.NewRequestWithContext(ctx, http.MethodPost, message.endpoint,
+		strings.NewReader(message.envelope)); err != nil {
+		return
+	}
+	for key, val := range testcase.Request.Header {
+		request.Header.Set(key, val)
+	}
+	message.setHeaders(request.Header)
+
+	if err = runJob(ctx, testcase.Before, dataContext, nil); err != nil {
+		return
+	}
+
+	r.log.Info("start to send request to %v\n", request.URL)
+	r.log.Debug("request body: %s\n", message.envelope)
+	var resp *http.Response
+	if resp, err = client.Do(request); err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	var data []byte
+	if data, err = io.ReadAll(resp.Body); err != nil {
+		return
+	}
+	record.Body = string(data)
+	r.response = SimpleResponse{
+		StatusCode: resp.StatusCode,
+		Header:     make(map[string]string),
+		Body:       string(data),
+	}
+	for key := range resp.Header {
+		r.response.Header[key] = resp.Header.Get(key)
+	}
+
+	if err = testcase.Expect.Render(dataContext); err != nil {
+		return
+	}
+
+	var body map[string]any
+	var fault *SOAPFault
+	if body, fault, err = parseSOAPResponse(service, message.operation, data); err != nil {
+		err = fmt.Errorf("failed to parse the SOAP response with status code %d: %v", resp.StatusCode, err)
+		return
+	}
+	if err = expectInt(testcase.Name, testcase.Expect.StatusCode, resp.StatusCode); err != nil {
+		// the fault is unexpected
+		if fault != nil {
+			err = fault
+		}
+		return
+	}
+	for key, val := range testcase.Expect.Header {
+		if err = expectString(testcase.Name, val, resp.Header.Get(key)); err != nil {
+			return
+		}
+	}
+
+	// the expected body is compared with the raw envelope
+	expect := testcase.Expect
+	if expect.Body != "" {
+		if err = expectString(testcase.Name, strings.TrimSpace(expect.Body), strings.TrimSpace(string(data))); err != nil {
+			return
+		}
+		expect.Body = ""
+	}
+
+	var jsonData []byte
+	if jsonData, err = json.Marshal(body); err != nil {
+		return
+	}
+	if output, err = verifyResponseBodyData(testcase.Name, expect, util.JSON, jsonData); err == nil {
+		err = jsonSchemaValidation(expect.Schema, jsonData)
+	}
+	if err == nil {
+		err = captureVariables(testcase, resp, jsonData, dataContext)
+	}
+	return
+}
+
+// GetSuggestedAPIs lists the operations of the WSDL, the api is taken as a filter of the operation names
+func (r *soapTestCaseRunner) GetSuggestedAPIs(suite *testing.TestSuite, api string) (result []*testing.TestCase, err error) {
+	if suite.Spec.URL == "" {
+		return
+	}
+
+	var wsdl string
+	if wsdl, err = render.Render("wsdl", suite.Spec.URL, map[string]any{
+		testing.ContextKeyGlobalParam: suite.Param,
+	}); err != nil {
+		return
+	}
+
+	// the WSDL is loaded again for every suggestion
+	ctx, closeScope := WithRunScope(context.Background())
+	defer func() {
+		_ = closeScope()
+	}()
+
+	insecure := suite.Spec.Secure != nil && suite.Spec.Secure.Insecure
+	var service *soapService
+	if service, err = loadSOAPService(ctx, util.TlsAwareHTTPClient(insecure), wsdl); err != nil {
+		return
+	}
+
+	found := map[string]bool{}
+	for _, item := range service.operations {
+		if found[item.Name] || !strings.Contains(item.Name, api) {
+			continue
+		}
+		found[item.Name] = true
+		operation := service.operation(item.Name, "")
+
+		name := operation.Name
+		var value any = map[string]any{}
+		if operation.Input != nil {
+			name = operation.Input.Name
+			value = soapSampleValue(service, operation.Input, 0)
+		}
+
+		var body []byte
+		if body, err = json.Marshal(map[string]any{name: value}); err != nil {
+			return
+		}
+		testcase := &testing.TestCase{
+			Name: operation.Name,
+			Request: testing.Request{
+				API:    util.EmptyThenDefault(suite.API, operation.Endpoint),
+				Method: http.MethodPost,
+				Body:   testing.NewRequestBody(string(body)),
+			},
+		}
+		if operation.Version == soapVersion12 {
+			testcase.Request.Header = map[string]string{util.ContentType: soapContentType12}
+		}
+		result = append(result, testcase)
+		if len(result) >= r.apiSuggestLimit {
+			return
+		}
+	}
+	return
+}
+
+func (r *soapTestCaseRunner) WithAPISuggestLimit(limit int) {
+	r.apiSuggestLimit = limit
+}
+
+// GetResponseRecord returns the response record
+func (r *soapTestCaseRunner) GetResponseRecord() SimpleResponse {
+	return r.response
+}
+
+// soapMessage is the envelope and the headers of a SOAP request
+type soapMessage struct {
+	operation *soapOperation
+	version   string
+	action    string
+	endpoint  string
+	envelope  string
+}
+
+// newSOAPMessage builds the envelope from a XML payload, or a structured body whose key is the operation.
+// The SOAP 1.2 is used if the content type is application/soap+xml, or it is the only binding of the operation.
+func newSOAPMessage(service *soapService, request testing.Request) (message *soapMessage, err error) {
+	message = &soapMessage{endpoint: request.API}
+	if strings.HasPrefix(request.Header[util.ContentType], soapContentType12) {
+		message.version = soapVersion12
+	}
+
+	var name, payload string
+	body := strings.TrimSpace(request.Body.String())
+	if strings.HasPrefix(body, "<") {
+		var root xml.Name
+		if root, err = xmlRootName(body); err != nil {
+			return
+		}
+		if root.Local == "Envelope" {
+			message.envelope = body
+		} else {
+			name, payload = root.Local, body
+		}
+	} else {
+		data := map[string]any{}
+		decoder := json.NewDecoder(strings.NewReader(body))
+		decoder.UseNumber()
+		if err = decoder.Decode(&data); err != nil || len(data) != 1 {
+			err = errors.New("the SOAP body should be a XML, or an object which has the operation as the only key")
+			return
+		}
+		for key := range data {
+			name = key
+		}
+
+		var element *xsdElement
+		if service != nil {
+			if message.operation = service.operation(name, message.version); message.operation == nil {
+				err = fmt.Errorf("operation %q is not found in the WSDL", name)
+				return
+			}
+			element = message.operation.Input
+		}
+		if payload, err = encodeSOAPElement(service, element, name, data[name], ""); err != nil {
+			return
+		}
+	}
+
+	if message.operation == nil && service != nil && name != "" {
+		message.operation = service.operation(name, message.version)
+	}
+	if message.operation != nil {
+		message.version = message.operation.Version
+		message.action = message.operation.Action
+		message.endpoint = util.EmptyThenDefault(message.endpoint, message.operation.Endpoint)
+	}
+	message.version = util.EmptyThenDefault(message.version, soapVersion11)
+
+	if message.envelope == "" {
+		namespace := soapEnvelope11
+		if message.version == soapVersion12 {
+			namespace = soapEnvelope12
+		}
+		message.envelope = fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>`+
+			`<soap:Envelope xmlns:soap="%s"><soap:Body>%s</soap:Body></soap:Envelope>`, namespace, payload)
+	}
+	if message.endpoint == "" {
+		err = errors.New("the endpoint is required, it could be the api of the request or the address in the WSDL")
+	}
+	return
+}
+
+// setHeaders sets the content type and the action if they are not given,
+// the SOAP 1.2 takes the action as a parameter of the content type
+func (m *soapMessage) setHeaders(header http.Header) {
+	if m.version == soapVersion12 {
+		if contentType := header.Get(util.ContentType); !strings.Contains(contentType, "action=") {
+			contentType = soapContentType12 + "; charset=utf-8"
+			if m.action != "" {
+				contentType += fmt.Sprintf(`; action="%s"`, m.action)
+			}
+			header.Set(util.ContentType, contentType)
+		}
+		return
+	}
+
+	if header.Get(util.ContentType) == "" {
+		header.Set(util.ContentType, "text/xml; charset=utf-8")
+	}
+	if header.Get("SOAPAction") == "" {
+		header.Set("SOAPAction", strconv.Quote(m.action))
+	}
+}
+
+func xmlRootName(data string) (name xml.Name, err error) {
+	decoder := xml.NewDecoder(strings.NewReader(data))
+	for {
+		var token xml.Token
+		if token, err = decoder.Token(); err != nil {
+			err = fmt.Errorf("invalid XML body: %v", err)
+			return
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			name = start.Name
+			return
+		}
+	}
+}
+
+// encodeSOAPElement writes the value as a XML element, the children are ordered as the schema.
+// The namespace is declared as the default one once it is different from the parent's.
+func encodeSOAPElement(service *soapService, element *xsdElement, name string, value any, parentNamespace string) (
+	result string, err error) {
+	if items, ok := value.([]any); ok {
+		for _, item := range items {
+			var itemResult string
+			if itemResult, err = encodeSOAPElement(service, element, name, item, parentNamespace); err != nil {
+				return
+			}
+			result += itemResult
+		}
+		return
+	}
+
+	namespace := parentNamespace
+	if service != nil && element != nil {
+		element = service.resolve(element)
+		namespace = element.namespace
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString("<" + name)
+	if namespace != parentNamespace {
+		buf.WriteString(` xmlns="`)
+		_ = xml.EscapeText(buf, []byte(namespace))
+		buf.WriteString(`"`)
+	}
+	buf.WriteString(">")
+
+	switch val := value.(type) {
+	case map[string]any:
+		var children []*xsdElement
+		if service != nil && element != nil {
+			children = service.children(service.complexType(element))
+		}
+
+		var keys []string
+		if len(children) > 0 {
+			for _, child := range children {
+				child = service.resolve(child)
+				if _, ok := val[child.Name]; ok {
+					keys = append(keys, child.Name)
+				}
+			}
+			if len(keys) != len(val) {
+				for key := range val {
+					if service.child(element, key) == nil {
+						err = fmt.Errorf("unknown field %q of %q", key, name)
+						return
+					}
+				}
+			}
+		} else {
+			for key := range val {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+		}
+
+		for _, key := range keys {
+			var child *xsdElement
+			if service != nil && element != nil {
+				child = service.child(element, key)
+			}
+
+			var childResult string
+			if childResult, err = encodeSOAPElement(service, child, key, val[key], namespace); err != nil {
+				return
+			}
+			buf.WriteString(childResult)
+		}
+	case nil:
+	default:
+		_ = xml.EscapeText(buf, []byte(fmt.Sprint(val)))
+	}
+	buf.WriteString("</" + name + ">")
+	result = buf.String()
+	return
+}
+
+// SOAPFault is the fault of a SOAP 1.1 or 1.2 response
+type SOAPFault struct {
+	Code    string `json:"code"`
+	Subcode string `json:"subcode,omitempty"`
+	Reason  string `json:"reason"`
+	Actor   string `json:"actor,omitempty"`
+	Detail  any    `json:"detail,omitempty"`
+}
+
+func (f *SOAPFault) Error() string {
+	return fmt.Sprintf("SOAP fault %s: %s", f.Code, f.Reason)
+}
+
+// xmlNode is a generic XML element
+type xmlNode struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Content string     `xml:",chardata"`
+	Nodes   []*xmlNode `xml:",any"`
+}
+
+func (n *xmlNode) child(name string) *xmlNode {
+	for _, node := range n.Nodes {
+		if node.XMLName.Local == name {
+			return node
+		}
+	}
+	return nil
+}
+
+func (n *xmlNode) text(path ...string) string {
+	node := n
+	for _, name := range path {
+		if node = node.child(name); node == nil {
+			return ""
+		}
+	}
+	return strings.TrimSpace(node.Content)
+}
+
+// parseSOAPResponse converts the body of the envelope to a map, the values are typed according to the schema
+func parseSOAPResponse(service *soapService, operation *soapOperation, data []byte) (
+	body map[string]any, fault *SOAPFault, err error) {
+	envelope := &xmlNode{}
+	if err = xml.Unmarshal(data, envelope); err != nil {
+		return
+	}
+
+	soapBody := envelope.child("Body")
+	if envelope.XMLName.Local != "Envelope" || soapBody == nil {
+		err = errors.New("no SOAP body is found")
+		return
+	}
+
+	body = map[string]any{}
+	for _, node := range soapBody.Nodes {
+		name := node.XMLName.Local
+		if name == "Fault" {
+			fault = parseSOAPFault(node)
+			body[name] = fault
+			continue
+		}
+
+		var element *xsdElement
+		if service != nil {
+			if operation != nil && operation.Output != nil && operation.Output.Name == name {
+				element = operation.Output
+			} else {
+				element = service.elements[name]
+			}
+		}
+		body[name] = convertXMLNode(service, element, node)
+	}
+	return
+}
+
+func parseSOAPFault(node *xmlNode) (fault *SOAPFault) {
+	fault = &SOAPFault{}
+	if node.child("Code") != nil {
+		// SOAP 1.2
+		fault.Code = node.text("Code", "Value")
+		fault.Subcode = node.text("Code", "Subcode", "Value")
+		fault.Reason = node.text("Reason", "Text")
+		fault.Actor = node.text("Role")
+		if detail := node.child("Detail"); detail != nil {
+			fault.Detail = convertXMLNode(nil, nil, detail)
+		}
+	} else {
+		fault.Code = node.text("faultcode")
+		fault.Reason = node.text("faultstring")
+		fault.Actor = node.text("faultactor")
+		if detail := node.child("detail"); detail != nil {
+			fault.Detail = convertXMLNode(nil, nil, detail)
+		}
+	}
+	return
+}
+
+// convertXMLNode converts the element to a map or a typed value, the repeated elements become a list
+func convertXMLNode(service *soapService, element *xsdElement, node *xmlNode) any {
+	for _, attr := range node.Attrs {
+		if attr.Name.Local == "nil" && attr.Value == "true" {
+			return nil
+		}
+	}
+
+	if len(node.Nodes) == 0 {
+		text := strings.TrimSpace(node.Content)
+		if service == nil {
+			return text
+		}
+		return xsdTypedValue(service.simpleType(element), text)
+	}
+
+	result := map[string]any{}
+	for _, childNode := range node.Nodes {
+		name := childNode.XMLName.Local
+		var child *xsdElement
+		if service != nil && element != nil {
+			child = service.child(element, name)
+		}
+
+		value := convertXMLNode(service, child, childNode)
+		if existing, ok := result[name]; ok {
+			if items, ok := existing.([]any); ok {
+				result[name] = append(items, value)
+			} else {
+				result[name] = []any{existing, value}
+			}
+		} else if child != nil && child.MaxOccurs != "" && child.MaxOccurs != "0" && child.MaxOccurs != "1" {
+			result[name] = []any{value}
+		} else {
+			result[name] = value
+		}
+	}
+	return result
+}
+
+// xsdTypedValue converts the text to a number or a boolean according to the XML schema type
+func xsdTypedValue(typeName, text string) any {
+	switch typeName {
+	case "int", "integer", "long", "short", "byte", "unsignedInt", "unsignedLong", "unsignedShort",
+		"unsignedByte", "positiveInteger", "nonNegativeInteger", "negativeInteger", "nonPositiveInteger":
+		if value, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return value
+		}
+	case "float", "double", "decimal":
+		if value, err := strconv.ParseFloat(text, 64); err == nil {
+			return value
+		}
+	case "boolean":
+		if value, err := strconv.ParseBool(text); err == nil {
+			return value
+		}
+	}
+	return text
+}
+
+// soapSampleValue generates a value of the element
+func soapSampleValue(service *soapService, element *xsdElement, depth int) (value any) {
+	element = service.resolve(element)
+	if complexType := service.complexType(element); complexType != nil {
+		result := map[string]any{}
+		if depth < 3 {
+			for _, child := range service.children(complexType) {
+				child = service.resolve(child)
+				result[child.Name] = soapSampleValue(service, child, depth+1)
+			}
+		}
+		value = result
+	} else {
+		switch typeName := service.simpleType(element); xsdTypedValue(typeName, "1").(type) {
+		case int64:
+			value = 101
+		case float64:
+			value = 1.5
+		case bool:
+			value = true
+		default:
+			value = "random"
+			if simpleType := service.simpleTypes[localName(element.Type)]; simpleType != nil &&
+				len(simpleType.Restriction.Enumerations) > 0 {
+				value = simpleType.Restriction.Enumerations[0].Value
+			}
+		}
+	}
+
+	if element.MaxOccurs != "" && element.MaxOccurs != "0" && element.MaxOccurs != "1" {
+		value = []any{value}
+	}
+	return
+}
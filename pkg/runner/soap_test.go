@@ -0,0 +1,263 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+const soapTestWSDL = "testdata/calculator.wsdl"
+
+func TestSOAPRunner(t *testing.T) {
+	var lastRequest *http.Request
+	var lastBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		lastRequest, lastBody = r, string(data)
+
+		switch {
+		case strings.Contains(lastBody, "<Summary"):
+			_, _ = io.WriteString(w, soapEnvelope(soapEnvelope11, `<SummaryResponse xmlns="http://tempuri.org/">`+
+				`<result><value>4.5</value><exact>true</exact><items>3</items></result></SummaryResponse>`))
+		case strings.Contains(lastBody, "<intA>0</intA>") && strings.Contains(lastBody, soapEnvelope12):
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = io.WriteString(w, soapEnvelope(soapEnvelope12, `<soap:Fault><soap:Code><soap:Value>soap:Sender</soap:Value>`+
+				`<soap:Subcode><soap:Value>InvalidArgument</soap:Value></soap:Subcode></soap:Code>`+
+				`<soap:Reason><soap:Text xml:lang="en">intA is zero</soap:Text></soap:Reason></soap:Fault>`))
+		case strings.Contains(lastBody, "<intA>0</intA>"):
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = io.WriteString(w, soapEnvelope(soapEnvelope11, `<soap:Fault><faultcode>soap:Client</faultcode>`+
+				`<faultstring>intA is zero</faultstring><detail><field>intA</field></detail></soap:Fault>`))
+		default:
+			_, _ = io.WriteString(w, soapEnvelope(soapEnvelope11,
+				`<AddResponse xmlns="http://tempuri.org/"><AddResult>3</AddResult></AddResponse>`))
+		}
+	}))
+	defer server.Close()
+
+	newCase := func(body string, header map[string]string) *atest.TestCase {
+		return &atest.TestCase{
+			Name: "add",
+			Request: atest.Request{
+				API:    server.URL,
+				Header: header,
+				Body:   atest.NewRequestBody(body),
+			},
+		}
+	}
+
+	t.Run("SOAP 1.1", func(t *testing.T) {
+		runner := NewSOAPTestCaseRunner(soapTestWSDL)
+		testcase := newCase(`{"Add":{"intB":2,"intA":1}}`, nil)
+		testcase.Expect.BodyFieldsExpect = map[string]interface{}{"AddResponse.AddResult": 3}
+		testcase.Expect.Verify = []string{"data.AddResponse.AddResult == 3"}
+
+		output, err := runner.RunTestCase(testcase, nil, context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"AddResponse": map[string]interface{}{"AddResult": float64(3)}}, output)
+		assert.Equal(t, "text/xml; charset=utf-8", lastRequest.Header.Get(util.ContentType))
+		assert.Equal(t, `"http://tempuri.org/Add"`, lastRequest.Header.Get("SOAPAction"))
+		assert.Contains(t, lastBody, `<soap:Envelope xmlns:soap="`+soapEnvelope11+`"><soap:Body>`+
+			`<Add xmlns="http://tempuri.org/"><intA>1</intA><intB>2</intB></Add></soap:Body></soap:Envelope>`)
+		assert.Equal(t, http.StatusOK, runner.(*soapTestCaseRunner).GetResponseRecord().StatusCode)
+	})
+
+	t.Run("rendered WSDL", func(t *testing.T) {
+		runner := NewSOAPTestCaseRunner("{{.param.wsdl}}")
+		_, err := runner.RunTestCase(newCase(`{"Add":{"intA":1,"intB":2}}`, nil), map[string]any{
+			atest.ContextKeyGlobalParam: map[string]string{"wsdl": soapTestWSDL},
+		}, context.TODO())
+		assert.NoError(t, err)
+		assert.Contains(t, lastBody, `<Add xmlns="http://tempuri.org/">`)
+	})
+
+	t.Run("SOAP 1.2", func(t *testing.T) {
+		runner := NewSOAPTestCaseRunner(soapTestWSDL)
+		_, err := runner.RunTestCase(newCase(`{"Add":{"intA":1,"intB":2}}`,
+			map[string]string{util.ContentType: soapContentType12}), nil, context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, `application/soap+xml; charset=utf-8; action="http://tempuri.org/Add"`,
+			lastRequest.Header.Get(util.ContentType))
+		assert.Empty(t, lastRequest.Header.Get("SOAPAction"))
+		assert.Contains(t, lastBody, soapEnvelope12)
+	})
+
+	t.Run("typed values", func(t *testing.T) {
+		runner := NewSOAPTestCaseRunner(soapTestWSDL)
+		testcase := newCase(`{"Summary":{"mode":"avg","values":[4,5]}}`, nil)
+		testcase.Expect.Verify = []string{"data.SummaryResponse.result.exact == true", "len(data.SummaryResponse.result.items) == 1"}
+
+		output, err := runner.RunTestCase(testcase, nil, context.TODO())
+		assert.NoError(t, err)
+		assert.Contains(t, lastBody, `<Summary xmlns="http://tempuri.org/"><mode>avg</mode><values>4</values><values>5</values></Summary>`)
+		assert.Equal(t, map[string]interface{}{"SummaryResponse": map[string]interface{}{
+			"result": map[string]interface{}{"value": 4.5, "exact": true, "items": []interface{}{float64(3)}},
+		}}, output)
+	})
+
+	t.Run("SOAP 1.1 fault", func(t *testing.T) {
+		runner := NewSOAPTestCaseRunner(soapTestWSDL)
+		_, err := runner.RunTestCase(newCase(`{"Add":{"intA":0,"intB":2}}`, nil), nil, context.TODO())
+		fault, ok := err.(*SOAPFault)
+		if assert.True(t, ok, err) {
+			assert.Equal(t, "soap:Client", fault.Code)
+			assert.Equal(t, "intA is zero", fault.Reason)
+			assert.Equal(t, map[string]any{"field": "intA"}, fault.Detail)
+			assert.Equal(t, "SOAP fault soap:Client: intA is zero", fault.Error())
+		}
+
+		// the fault is expected
+		testcase := newCase(`{"Add":{"intA":0,"intB":2}}`, nil)
+		testcase.Expect.StatusCode = http.StatusInternalServerError
+		testcase.Expect.BodyFieldsExpect = map[string]interface{}{"Fault.code": "soap:Client"}
+		_, err = runner.RunTestCase(testcase, nil, context.TODO())
+		assert.NoError(t, err)
+	})
+
+	t.Run("SOAP 1.2 fault", func(t *testing.T) {
+		runner := NewSOAPTestCaseRunner(soapTestWSDL)
+		_, err := runner.RunTestCase(newCase(`{"Add":{"intA":0,"intB":2}}`,
+			map[string]string{util.ContentType: soapContentType12}), nil, context.TODO())
+		fault, ok := err.(*SOAPFault)
+		if assert.True(t, ok, err) {
+			assert.Equal(t, &SOAPFault{Code: "soap:Sender", Subcode: "InvalidArgument", Reason: "intA is zero"}, fault)
+		}
+	})
+
+	t.Run("XML body without WSDL", func(t *testing.T) {
+		runner := NewSOAPTestCaseRunner("")
+		testcase := newCase(`<Add xmlns="http://tempuri.org/"><intA>1</intA><intB>2</intB></Add>`,
+			map[string]string{"SOAPAction": "http://tempuri.org/Add"})
+		testcase.Expect.BodyFieldsExpect = map[string]interface{}{"AddResponse.AddResult": "3"}
+		_, err := runner.RunTestCase(testcase, nil, context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, "http://tempuri.org/Add", lastRequest.Header.Get("SOAPAction"))
+		assert.Contains(t, lastBody, `<soap:Body><Add xmlns="http://tempuri.org/"><intA>1</intA>`)
+	})
+
+	t.Run("unknown operation", func(t *testing.T) {
+		runner := NewSOAPTestCaseRunner(soapTestWSDL)
+		_, err := runner.RunTestCase(newCase(`{"Minus":{"intA":1}}`, nil), nil, context.TODO())
+		assert.ErrorContains(t, err, `operation "Minus" is not found`)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		runner := NewSOAPTestCaseRunner(soapTestWSDL)
+		_, err := runner.RunTestCase(newCase(`{"Add":{"intA":1,"intC":2}}`, nil), nil, context.TODO())
+		assert.ErrorContains(t, err, `unknown field "intC"`)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		runner := NewSOAPTestCaseRunner(soapTestWSDL)
+		_, err := runner.RunTestCase(newCase(`{"Add":{},"Summary":{}}`, nil), nil, context.TODO())
+		assert.Error(t, err)
+	})
+}
+
+func TestSOAPSuggestedAPIs(t *testing.T) {
+	runner := NewSOAPTestCaseRunner(soapTestWSDL)
+	suite := &atest.TestSuite{Spec: atest.APISpec{Kind: "soap", URL: soapTestWSDL}}
+
+	result, err := runner.GetSuggestedAPIs(suite, "")
+	assert.NoError(t, err)
+	if assert.Len(t, result, 2) {
+		assert.Equal(t, "Add", result[0].Name)
+		assert.Equal(t, "http://localhost/calculator.asmx", result[0].Request.API)
+		assert.Equal(t, `{"Add":{"intA":101,"intB":101}}`, result[0].Request.Body.String())
+		assert.Equal(t, "Summary", result[1].Name)
+		assert.Equal(t, `{"Summary":{"mode":"sum","values":[1.5]}}`, result[1].Request.Body.String())
+	}
+
+	result, err = runner.GetSuggestedAPIs(suite, "Sum")
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+
+	runner.WithAPISuggestLimit(1)
+	result, err = runner.GetSuggestedAPIs(suite, "")
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+
+	result, err = runner.GetSuggestedAPIs(&atest.TestSuite{
+		Spec:  atest.APISpec{Kind: "soap", URL: "{{.param.wsdl}}"},
+		Param: map[string]string{"wsdl": soapTestWSDL},
+	}, "")
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+
+	_, err = runner.GetSuggestedAPIs(&atest.TestSuite{Spec: atest.APISpec{URL: "testdata/swagger.json"}}, "")
+	assert.Error(t, err)
+}
+
+func TestParseWSDL(t *testing.T) {
+	service, err := loadSOAPService(context.TODO(), http.DefaultClient, soapTestWSDL)
+	assert.NoError(t, err)
+	assert.Len(t, service.operations, 3)
+
+	operation := service.operation("Add", soapVersion12)
+	if assert.NotNil(t, operation) {
+		assert.Equal(t, soapVersion12, operation.Version)
+		assert.Equal(t, "http://tempuri.org/Add", operation.Action)
+		assert.Equal(t, "AddResponse", operation.Output.Name)
+	}
+	// only SOAP 1.1 binding has the Summary operation
+	assert.Equal(t, soapVersion11, service.operation("Summary", soapVersion12).Version)
+	assert.Nil(t, service.operation("Minus", ""))
+
+	_, err = parseWSDL([]byte(`<definitions/>`))
+	assert.Error(t, err)
+
+	t.Run("the remote WSDL is cached in a run", func(t *testing.T) {
+		wsdl, err := os.ReadFile(soapTestWSDL)
+		assert.NoError(t, err)
+		var requestCount atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestCount.Add(1)
+			_, _ = w.Write(wsdl)
+		}))
+		defer server.Close()
+
+		ctx, closeScope := WithRunScope(context.Background())
+		for range 2 {
+			_, err = loadSOAPService(ctx, http.DefaultClient, server.URL)
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, int32(1), requestCount.Load())
+		assert.NoError(t, closeScope())
+
+		ctx, closeScope = WithRunScope(context.Background())
+		defer closeScope()
+		_, err = loadSOAPService(ctx, http.DefaultClient, server.URL)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), requestCount.Load(), "the WSDL is not cached across the runs")
+	})
+}
+
+func soapEnvelope(namespace, body string) string {
+	return `<?xml version="1.0" encoding="utf-8"?><soap:Envelope xmlns:soap="` + namespace + `"><soap:Body>` +
+		body + `</soap:Body></soap:Envelope>`
+}
@@ -0,0 +1,438 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// the SOAP versions
+const (
+	soapVersion11 = "1.1"
+	soapVersion12 = "1.2"
+)
+
+type wsdlDefinitions struct {
+	TargetNamespace string `xml:"targetNamespace,attr"`
+	Types           struct {
+		Schemas []*xsdSchema `xml:"schema"`
+	} `xml:"types"`
+	Messages  []wsdlMessage  `xml:"message"`
+	PortTypes []wsdlPortType `xml:"portType"`
+	Bindings  []wsdlBinding  `xml:"binding"`
+	Services  []wsdlService  `xml:"service"`
+}
+
+type wsdlMessage struct {
+	Name  string `xml:"name,attr"`
+	Parts []struct {
+		Name    string `xml:"name,attr"`
+		Element string `xml:"element,attr"`
+		Type    string `xml:"type,attr"`
+	} `xml:"part"`
+}
+
+type wsdlPortType struct {
+	Name       string `xml:"name,attr"`
+	Operations []struct {
+		Name   string         `xml:"name,attr"`
+		Input  wsdlMessageRef `xml:"input"`
+		Output wsdlMessageRef `xml:"output"`
+	} `xml:"operation"`
+}
+
+type wsdlMessageRef struct {
+	Message string `xml:"message,attr"`
+}
+
+type wsdlBinding struct {
+	Name       string                 `xml:"name,attr"`
+	Type       string                 `xml:"type,attr"`
+	SOAP       *wsdlSOAPBinding       `xml:"http://schemas.xmlsoap.org/wsdl/soap/ binding"`
+	SOAP12     *wsdlSOAPBinding       `xml:"http://schemas.xmlsoap.org/wsdl/soap12/ binding"`
+	Operations []wsdlBindingOperation `xml:"operation"`
+}
+
+type wsdlSOAPBinding struct {
+	Style string `xml:"style,attr"`
+}
+
+type wsdlBindingOperation struct {
+	Name   string             `xml:"name,attr"`
+	SOAP   *wsdlSOAPOperation `xml:"http://schemas.xmlsoap.org/wsdl/soap/ operation"`
+	SOAP12 *wsdlSOAPOperation `xml:"http://schemas.xmlsoap.org/wsdl/soap12/ operation"`
+	Input  struct {
+		Body struct {
+			Namespace string `xml:"namespace,attr"`
+		} `xml:"body"`
+	} `xml:"input"`
+}
+
+type wsdlSOAPOperation struct {
+	Action string `xml:"soapAction,attr"`
+	Style  string `xml:"style,attr"`
+}
+
+type wsdlService struct {
+	Name  string `xml:"name,attr"`
+	Ports []struct {
+		Binding string `xml:"binding,attr"`
+		Address struct {
+			Location string `xml:"location,attr"`
+		} `xml:"address"`
+	} `xml:"port"`
+}
+
+type xsdSchema struct {
+	TargetNamespace    string            `xml:"targetNamespace,attr"`
+	ElementFormDefault string            `xml:"elementFormDefault,attr"`
+	Elements           []*xsdElement     `xml:"element"`
+	ComplexTypes       []*xsdComplexType `xml:"complexType"`
+	SimpleTypes        []*xsdSimpleType  `xml:"simpleType"`
+}
+
+type xsdElement struct {
+	Name        string          `xml:"name,attr"`
+	Type        string          `xml:"type,attr"`
+	Ref         string          `xml:"ref,attr"`
+	MaxOccurs   string          `xml:"maxOccurs,attr"`
+	ComplexType *xsdComplexType `xml:"complexType"`
+	SimpleType  *xsdSimpleType  `xml:"simpleType"`
+
+	// namespace is the namespace of the element, it is empty if the element is unqualified
+	namespace string
+}
+
+type xsdComplexType struct {
+	Name     string        `xml:"name,attr"`
+	Sequence []*xsdElement `xml:"sequence>element"`
+	All      []*xsdElement `xml:"all>element"`
+	Choice   []*xsdElement `xml:"choice>element"`
+	Content  *struct {
+		Base     string        `xml:"base,attr"`
+		Sequence []*xsdElement `xml:"sequence>element"`
+	} `xml:"complexContent>extension"`
+}
+
+type xsdSimpleType struct {
+	Name        string `xml:"name,attr"`
+	Restriction struct {
+		Base         string `xml:"base,attr"`
+		Enumerations []struct {
+			Value string `xml:"value,attr"`
+		} `xml:"enumeration"`
+	} `xml:"restriction"`
+}
+
+// soapService is the operations and the types of a WSDL
+type soapService struct {
+	operations   []*soapOperation
+	elements     map[string]*xsdElement
+	complexTypes map[string]*xsdComplexType
+	simpleTypes  map[string]*xsdSimpleType
+}
+
+// soapOperation is an operation of a SOAP binding
+type soapOperation struct {
+	Name    string
+	Action  string
+	Version string
+	// RPC is true for the rpc style, the input element is a wrapper named after the operation
+	RPC      bool
+	Endpoint string
+	Input    *xsdElement
+	Output   *xsdElement
+}
+
+// localName removes the namespace prefix of a qualified name
+func localName(name string) string {
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// parseWSDL parses the WSDL 1.1 document, both the SOAP 1.1 and 1.2 bindings are supported
+func parseWSDL(data []byte) (service *soapService, err error) {
+	definitions := &wsdlDefinitions{}
+	if err = xml.Unmarshal(data, definitions); err != nil {
+		err = fmt.Errorf("failed to parse the WSDL: %v", err)
+		return
+	}
+
+	service = &soapService{
+		elements:     map[string]*xsdElement{},
+		complexTypes: map[string]*xsdComplexType{},
+		simpleTypes:  map[string]*xsdSimpleType{},
+	}
+	for _, schema := range definitions.Types.Schemas {
+		qualified := schema.ElementFormDefault == "qualified"
+		for _, element := range schema.Elements {
+			// the global elements are always qualified
+			element.namespace = schema.TargetNamespace
+			qualifyElements(element.ComplexType, schema.TargetNamespace, qualified)
+			service.elements[element.Name] = element
+		}
+		for _, complexType := range schema.ComplexTypes {
+			qualifyElements(complexType, schema.TargetNamespace, qualified)
+			service.complexTypes[complexType.Name] = complexType
+		}
+		for _, simpleType := range schema.SimpleTypes {
+			service.simpleTypes[simpleType.Name] = simpleType
+		}
+	}
+
+	messages := map[string]wsdlMessage{}
+	for _, message := range definitions.Messages {
+		messages[message.Name] = message
+	}
+	portTypes := map[string]wsdlPortType{}
+	for _, portType := range definitions.PortTypes {
+		portTypes[portType.Name] = portType
+	}
+	endpoints := map[string]string{}
+	for _, item := range definitions.Services {
+		for _, port := range item.Ports {
+			endpoints[localName(port.Binding)] = port.Address.Location
+		}
+	}
+
+	for _, binding := range definitions.Bindings {
+		version, soapBinding := soapVersion11, binding.SOAP
+		if binding.SOAP12 != nil {
+			version, soapBinding = soapVersion12, binding.SOAP12
+		}
+		if soapBinding == nil {
+			// not a SOAP binding, such as the HTTP binding
+			continue
+		}
+
+		portType := portTypes[localName(binding.Type)]
+		for _, bindingOperation := range binding.Operations {
+			operation := &soapOperation{
+				Name:     bindingOperation.Name,
+				Version:  version,
+				RPC:      soapBinding.Style == "rpc",
+				Endpoint: endpoints[binding.Name],
+			}
+			soapOperation := bindingOperation.SOAP
+			if soapOperation == nil {
+				soapOperation = bindingOperation.SOAP12
+			}
+			if soapOperation != nil {
+				operation.Action = soapOperation.Action
+				if soapOperation.Style != "" {
+					operation.RPC = soapOperation.Style == "rpc"
+				}
+			}
+
+			for _, item := range portType.Operations {
+				if item.Name != operation.Name {
+					continue
+				}
+				namespace := util.EmptyThenDefault(bindingOperation.Input.Body.Namespace, definitions.TargetNamespace)
+				operation.Input = service.messageElement(messages[localName(item.Input.Message)], operation.Name,
+					namespace, operation.RPC)
+				operation.Output = service.messageElement(messages[localName(item.Output.Message)], operation.Name+"Response",
+					namespace, operation.RPC)
+			}
+			service.operations = append(service.operations, operation)
+		}
+	}
+
+	if len(service.operations) == 0 {
+		err = fmt.Errorf("no SOAP operation is found in the WSDL")
+	}
+	return
+}
+
+// qualifyElements sets the namespace of the local elements
+func qualifyElements(complexType *xsdComplexType, namespace string, qualified bool) {
+	if complexType == nil {
+		return
+	}
+	for _, element := range complexType.children() {
+		if element.namespace != "" || element.Ref != "" {
+			continue
+		}
+		if qualified {
+			element.namespace = namespace
+		}
+		qualifyElements(element.ComplexType, namespace, qualified)
+	}
+}
+
+func (t *xsdComplexType) children() (elements []*xsdElement) {
+	elements = append(elements, t.Sequence...)
+	elements = append(elements, t.All...)
+	elements = append(elements, t.Choice...)
+	if t.Content != nil {
+		elements = append(elements, t.Content.Sequence...)
+	}
+	return
+}
+
+// messageElement returns the element of the message body. The element of the first part is taken
+// for the document style, and the parts are wrapped by an element named after the operation for the rpc style.
+func (s *soapService) messageElement(message wsdlMessage, name, namespace string, rpc bool) *xsdElement {
+	if !rpc {
+		for _, part := range message.Parts {
+			if part.Element != "" {
+				return s.elements[localName(part.Element)]
+			}
+		}
+		return nil
+	}
+
+	wrapper := &xsdElement{
+		Name:        name,
+		namespace:   namespace,
+		ComplexType: &xsdComplexType{},
+	}
+	for _, part := range message.Parts {
+		element := &xsdElement{Name: part.Name, Type: part.Type}
+		if target, ok := s.elements[localName(part.Element)]; ok {
+			element = target
+		}
+		wrapper.ComplexType.Sequence = append(wrapper.ComplexType.Sequence, element)
+	}
+	return wrapper
+}
+
+// operation finds the operation by the name or the input element name,
+// the given version is preferred, or SOAP 1.1 if the version is empty
+func (s *soapService) operation(name, version string) (result *soapOperation) {
+	version = util.EmptyThenDefault(version, soapVersion11)
+	for _, operation := range s.operations {
+		if operation.Name != name && (operation.Input == nil || operation.Input.Name != name) {
+			continue
+		}
+		if result == nil || operation.Version == version {
+			result = operation
+		}
+	}
+	return
+}
+
+// resolve returns the referenced element if there is
+func (s *soapService) resolve(element *xsdElement) *xsdElement {
+	if element != nil && element.Ref != "" {
+		if target, ok := s.elements[localName(element.Ref)]; ok {
+			return target
+		}
+	}
+	return element
+}
+
+// complexType returns the inline or the named complex type of the element
+func (s *soapService) complexType(element *xsdElement) *xsdComplexType {
+	element = s.resolve(element)
+	switch {
+	case element == nil:
+		return nil
+	case element.ComplexType != nil:
+		return element.ComplexType
+	default:
+		return s.complexTypes[localName(element.Type)]
+	}
+}
+
+// children returns the child elements of the complex type, including the ones of the base type
+func (s *soapService) children(complexType *xsdComplexType) (elements []*xsdElement) {
+	if complexType == nil {
+		return
+	}
+	if complexType.Content != nil {
+		elements = append(elements, s.children(s.complexTypes[localName(complexType.Content.Base)])...)
+	}
+	return append(elements, complexType.children()...)
+}
+
+// child finds the child element by the name
+func (s *soapService) child(element *xsdElement, name string) *xsdElement {
+	for _, item := range s.children(s.complexType(element)) {
+		if item = s.resolve(item); item.Name == name {
+			return item
+		}
+	}
+	return nil
+}
+
+// simpleType returns the builtin type of the element, such as: int, string
+func (s *soapService) simpleType(element *xsdElement) string {
+	element = s.resolve(element)
+	if element == nil {
+		return ""
+	}
+
+	typeName := localName(element.Type)
+	simpleType := element.SimpleType
+	if simpleType == nil {
+		simpleType = s.simpleTypes[typeName]
+	}
+	if simpleType != nil {
+		typeName = localName(simpleType.Restriction.Base)
+	}
+	return typeName
+}
+
+// loadSOAPService loads the WSDL from a local file or URL, it is cached by the source in the run scope
+func loadSOAPService(ctx context.Context, client *http.Client, source string) (service *soapService, err error) {
+	var value any
+	if value, err = getRunScope(ctx).wsdl.load(source, func() (value any, digests map[string]string, err error) {
+		var data []byte
+		if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+			data, err = requestWSDL(ctx, client, source)
+		} else if data, err = os.ReadFile(source); err == nil {
+			digests = map[string]string{source: contentDigest(data)}
+		}
+
+		if err == nil {
+			value, err = parseWSDL(data)
+		}
+		return
+	}); err == nil {
+		service = value.(*soapService)
+	}
+	return
+}
+
+func requestWSDL(ctx context.Context, client *http.Client, api string) (data []byte, err error) {
+	var req *http.Request
+	if req, err = http.NewRequestWithContext(ctx, http.MethodGet, api, nil); err != nil {
+		return
+	}
+
+	var resp *http.Response
+	if resp, err = client.Do(req); err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	if data, err = io.ReadAll(resp.Body); err == nil && resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("failed to get the WSDL from %q, status code: %d", api, resp.StatusCode)
+	}
+	return
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// the encodings of the socket payload
+const (
+	socketEncodingText   = "text"
+	socketEncodingHex    = "hex"
+	socketEncodingBase64 = "base64"
+)
+
+// the max size of an UDP datagram
+const udpMaxDatagramSize = 64 * 1024
+
+type socketTestCaseRunner struct {
+	UnimplementedRunner
+	// network is tcp or udp
+	network string
+	// address is the default address, it is overridden by the api of the request
+	address  string
+	response SimpleResponse
+}
+
+// NewSocketTestCaseRunner creates a runner which sends the raw payload over TCP or UDP
+func NewSocketTestCaseRunner(network, address string) TestCaseRunner {
+	return &socketTestCaseRunner{
+		UnimplementedRunner: NewDefaultUnimplementedRunner(),
+		network:             network,
+		address:             address,
+	}
+}
+
+func init() {
+	for _, network := range []string{"tcp", "udp"} {
+		RegisterRunner(network, func(suite *testing.TestSuite) TestCaseRunner {
+			return NewSocketTestCaseRunner(network, suite.API)
+		})
+	}
+}
+
+// socketRequest is the request body, it could be a text payload as well
+type socketRequest struct {
+	Payload string `json:"payload"`
+	// Encoding is the encoding of the payload and the delimiter: text, hex or base64, default is text
+	Encoding  string `json:"encoding"`
+	Delimiter string `json:"delimiter"`
+	Length    int    `json:"length"`
+	Timeout   string `json:"timeout"`
+
+	payload   []byte
+	delimiter []byte
+	timeout   time.Duration
+}
+
+// SocketResponse is the received data of a socket, the binary data could be verified by the hex or the bytes
+type SocketResponse struct {
+	Text   string `json:"text"`
+	Hex    string `json:"hex"`
+	Base64 string `json:"base64"`
+	Bytes  []int  `json:"bytes"`
+	Length int    `json:"length"`
+}
+
+func newSocketResponse(data []byte) *SocketResponse {
+	resp := &SocketResponse{
+		Text:   string(data),
+		Hex:    hex.EncodeToString(data),
+		Base64: base64.StdEncoding.EncodeToString(data),
+		Bytes:  make([]int, len(data)),
+		Length: len(data),
+	}
+	for i, b := range data {
+		resp.Bytes[i] = int(b)
+	}
+	return resp
+}
+
+// RunTestCase sends the payload and reads the response until the delimiter, the length or the timeout
+func (r *socketTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataContext any, ctx context.Context) (output any, err error) {
+	r.log.Info("start to run: '%s'\n", testcase.Name)
+	record := NewReportRecord()
+	defer func(rr *ReportRecord) {
+		rr.EndTime = time.Now()
+		rr.Error = err
+		rr.Group = testcase.Group
+		rr.Name = testcase.Name
+		rr.API = testcase.Request.API
+		rr.Method = strings.ToUpper(r.network)
+		r.testReporter.PutRecord(rr)
+	}(record)
+
+	contextDir := NewContextKeyBuilder().ParentDir().GetContextValueOrEmpty(ctx)
+	if err = testcase.Request.Render(dataContext, contextDir); err != nil {
+		return
+	}
+
+	network, address := parseSocketAddress(r.network, util.EmptyThenDefault(testcase.Request.API, r.address))
+	if address == "" {
+		err = errors.New("the address is required, it could be the api of the test suite or the request")
+		return
+	}
+
+	var request *socketRequest
+	if request, err = parseSocketRequest(testcase.Request.Body.String()); err != nil {
+		return
+	}
+
+	if err = runJob(ctx, testcase.Before, dataContext, nil); err != nil {
+		return
+	}
+
+	r.log.Info("start to send %d bytes to %s://%s\n", len(request.payload), network, address)
+	var data []byte
+	if data, err = exchangeSocket(ctx, network, address, request); err != nil {
+		return
+	}
+	record.Body = string(data)
+	r.response = SimpleResponse{
+		Header:  map[string]string{},
+		Body:    string(data),
+		RawBody: data,
+	}
+
+	var jsonData []byte
+	if jsonData, err = json.Marshal(newSocketResponse(data)); err != nil {
+		return
+	}
+
+	if err = testcase.Expect.Render(dataContext); err != nil {
+		return
+	}
+
+	// the expected body is compared with the text
+	expect := testcase.Expect
+	if expect.Body != "" {
+		if err = expectString(testcase.Name, expect.Body, string(data)); err != nil {
+			return
+		}
+		expect.Body = ""
+	}
+	if output, err = verifyResponseBodyData(testcase.Name, expect, util.JSON, jsonData); err == nil {
+		err = jsonSchemaValidation(expect.Schema, jsonData)
+	}
+	if err == nil {
+		err = captureVariables(testcase, &http.Response{Header: http.Header{}}, jsonData, dataContext)
+	}
+	if err == nil {
+		err = runJob(ctx, testcase.After, dataContext, output)
+	}
+	return
+}
+
+// GetResponseRecord returns the response record
+func (r *socketTestCaseRunner) GetResponseRecord() SimpleResponse {
+	return r.response
+}
+
+// parseSocketAddress takes the network from the scheme of the address if there is, such as: udp://localhost:9000
+func parseSocketAddress(network, address string) (string, string) {
+	address = strings.TrimSpace(address)
+	if scheme, rest, ok := strings.Cut(address, "://"); ok {
+		network, address = scheme, rest
+	}
+	return network, strings.TrimSuffix(address, "/")
+}
+
+// parseSocketRequest takes the body as a text payload, unless it is an object which has the request fields only.
+// So that a JSON payload could be sent as it is.
+func parseSocketRequest(body string) (request *socketRequest, err error) {
+	request = &socketRequest{Payload: body}
+	if isSocketRequestObject(body) {
+		if err = json.Unmarshal([]byte(body), request); err != nil {
+			err = fmt.Errorf("invalid socket request body: %v", err)
+			return
+		}
+	}
+
+	if request.payload, err = decodeSocketData(request.Payload, request.Encoding); err != nil {
+		err = fmt.Errorf("invalid payload: %v", err)
+		return
+	}
+	if request.delimiter, err = decodeSocketData(request.Delimiter, request.Encoding); err != nil {
+		err = fmt.Errorf("invalid delimiter: %v", err)
+		return
+	}
+
+	request.timeout = defaultStreamStepTimeout
+	if request.Timeout != "" {
+		if request.timeout, err = time.ParseDuration(request.Timeout); err != nil {
+			err = fmt.Errorf("invalid timeout: %v", err)
+		}
+	}
+	return
+}
+
+func isSocketRequestObject(body string) bool {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(body), &fields); err != nil || len(fields) == 0 {
+		return false
+	}
+	for key := range fields {
+		switch key {
+		case "payload", "encoding", "delimiter", "length", "timeout":
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func decodeSocketData(data, encoding string) (result []byte, err error) {
+	switch encoding {
+	case "", socketEncodingText:
+		result = []byte(data)
+	case socketEncodingHex:
+		// the spaces are allowed for the readability, such as: 01 02 0a
+		result, err = hex.DecodeString(strings.Join(strings.Fields(data), ""))
+	case socketEncodingBase64:
+		result, err = base64.StdEncoding.DecodeString(strings.TrimSpace(data))
+	default:
+		err = fmt.Errorf("unsupported encoding %q, it should be one of %s, %s and %s",
+			encoding, socketEncodingText, socketEncodingHex, socketEncodingBase64)
+	}
+	return
+}
+
+// exchangeSocket sends the payload, then reads until the delimiter or the length is reached.
+// It reads until the timeout, the connection is closed, or the first UDP datagram if neither of them is given.
+func exchangeSocket(ctx context.Context, network, address string, request *socketRequest) (data []byte, err error) {
+	ctx, cancel := context.WithTimeout(ctx, request.timeout)
+	defer cancel()
+
+	var conn net.Conn
+	dialer := &net.Dialer{}
+	if conn, err = dialer.DialContext(ctx, network, address); err != nil {
+		return
+	}
+	defer conn.Close()
+
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetDeadline(deadline); err != nil {
+		return
+	}
+	if len(request.payload) > 0 {
+		if _, err = conn.Write(request.payload); err != nil {
+			return
+		}
+	}
+
+	isUDP := strings.HasPrefix(network, "udp")
+	bounded := len(request.delimiter) > 0 || request.Length > 0
+	buf := make([]byte, udpMaxDatagramSize)
+	for {
+		var n int
+		n, err = conn.Read(buf)
+		data = append(data, buf[:n]...)
+
+		switch {
+		case len(request.delimiter) > 0 && bytes.Contains(data, request.delimiter):
+			data = data[:bytes.Index(data, request.delimiter)+len(request.delimiter)]
+			err = nil
+			return
+		case request.Length > 0 && len(data) >= request.Length:
+			data = data[:request.Length]
+			err = nil
+			return
+		case err == nil && isUDP && !bounded:
+			return
+		case err == nil:
+			continue
+		case !bounded && (errors.Is(err, io.EOF) || errors.Is(err, os.ErrDeadlineExceeded)):
+			err = nil
+		case errors.Is(err, os.ErrDeadlineExceeded):
+			err = fmt.Errorf("no expected data received in %v, got %q", request.timeout, data)
+		case errors.Is(err, io.EOF):
+			err = fmt.Errorf("the connection is closed before receiving the expected data, got %q", data)
+		}
+		return
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestTCPRunner(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				line, err := bufio.NewReader(conn).ReadString('\n')
+				if err != nil {
+					return
+				}
+
+				switch line = strings.TrimSpace(line); line {
+				case "PING":
+					_, _ = conn.Write([]byte("PONG\r\nignored"))
+				case "BINARY":
+					_, _ = conn.Write([]byte{0x01, 0x02, 0xff, 0x00, 0x7f})
+				case "CLOSE":
+					_, _ = conn.Write([]byte("bye"))
+				case "SILENT":
+					_, _ = bufio.NewReader(conn).ReadString('\n')
+				default:
+					_, _ = conn.Write([]byte(line + "\n"))
+				}
+			}(conn)
+		}
+	}()
+
+	suite := &atest.TestSuite{}
+	err = yaml.Unmarshal([]byte(`
+name: telemetry
+api: `+listener.Addr().String()+`
+spec:
+  kind: tcp
+items:
+- name: text
+  request:
+    body:
+      payload: "PING\n"
+      delimiter: "\r\n"
+  expect:
+    body: "PONG\r\n"
+    verify:
+    - data.text == "PONG\r\n"
+    - data.length == 6
+- name: hex
+  request:
+    body:
+      payload: 42 49 4e 41 52 59 0a
+      encoding: hex
+      length: 4
+  expect:
+    bodyFieldsExpect:
+      hex: 0102ff00
+    verify:
+    - data.bytes[2] == 255
+- name: base64
+  request:
+    body:
+      payload: Q0xPU0UK
+      encoding: base64
+  expect:
+    verify:
+    - data.base64 == "Ynll"
+- name: json
+  request:
+    body: "{\"id\": \"{{.param.id}}\"}\n"
+  expect:
+    bodyFieldsExpect:
+      text: "{\"id\": \"1\"}\n"
+`), suite)
+	assert.NoError(t, err)
+
+	runner := GetTestSuiteRunner(suite)
+	dataContext := map[string]interface{}{
+		atest.ContextKeyGlobalParam: map[string]string{"id": "1"},
+	}
+	for i := range suite.Items {
+		_, err = runner.RunTestCase(&suite.Items[i], dataContext, context.TODO())
+		assert.NoError(t, err, suite.Items[i].Name)
+	}
+	assert.Equal(t, []byte("{\"id\": \"1\"}\n"), runner.(*socketTestCaseRunner).GetResponseRecord().RawBody)
+
+	t.Run("read until timeout", func(t *testing.T) {
+		output, err := runner.RunTestCase(&atest.TestCase{
+			Request: atest.Request{
+				Body: atest.NewRequestBody(`{"payload": "SILENT\n", "timeout": "100ms"}`),
+			},
+		}, dataContext, context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, float64(0), output.(map[string]interface{})["length"])
+	})
+
+	t.Run("no delimiter in time", func(t *testing.T) {
+		_, err := runner.RunTestCase(&atest.TestCase{
+			Request: atest.Request{
+				Body: atest.NewRequestBody(`{"payload": "SILENT\n", "delimiter": "\n", "timeout": "100ms"}`),
+			},
+		}, dataContext, context.TODO())
+		assert.ErrorContains(t, err, "no expected data received in 100ms")
+	})
+
+	t.Run("closed before the length", func(t *testing.T) {
+		_, err := runner.RunTestCase(&atest.TestCase{
+			Request: atest.Request{
+				Body: atest.NewRequestBody(`{"payload": "CLOSE\n", "length": 10}`),
+			},
+		}, dataContext, context.TODO())
+		assert.ErrorContains(t, err, "the connection is closed")
+	})
+
+	t.Run("unexpected body", func(t *testing.T) {
+		_, err := runner.RunTestCase(&atest.TestCase{
+			Request: atest.Request{Body: atest.NewRequestBody("hello\n")},
+			Expect:  atest.Response{Body: "world\n"},
+		}, dataContext, context.TODO())
+		assert.Error(t, err)
+	})
+}
+
+func TestUDPRunner(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			_, _ = conn.WriteTo([]byte(strings.ToUpper(string(buf[:n]))), addr)
+		}
+	}()
+
+	runner := NewSocketTestCaseRunner("udp", "")
+	output, err := runner.RunTestCase(&atest.TestCase{
+		Request: atest.Request{
+			API:  "udp://" + conn.LocalAddr().String(),
+			Body: atest.NewRequestBody("hello"),
+		},
+		Expect: atest.Response{
+			Verify: []string{`data.text == "HELLO"`},
+		},
+	}, nil, context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, "48454c4c4f", output.(map[string]interface{})["hex"])
+
+	_, err = NewSocketTestCaseRunner("tcp", "").RunTestCase(&atest.TestCase{}, nil, context.TODO())
+	assert.ErrorContains(t, err, "the address is required")
+}
+
+func TestParseSocketRequest(t *testing.T) {
+	request, err := parseSocketRequest(`{"payload": "01 0a", "encoding": "hex", "delimiter": "0a", "timeout": "1s"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x0a}, request.payload)
+	assert.Equal(t, []byte{0x0a}, request.delimiter)
+
+	// a JSON payload is sent as it is
+	request, err = parseSocketRequest(`{"payload": "a", "name": "b"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"payload": "a", "name": "b"}`, string(request.payload))
+
+	_, err = parseSocketRequest(`{"payload": "zz", "encoding": "hex"}`)
+	assert.Error(t, err)
+
+	_, err = parseSocketRequest(`{"payload": "a", "encoding": "utf16"}`)
+	assert.Error(t, err)
+
+	_, err = parseSocketRequest(`{"payload": "a", "timeout": "1"}`)
+	assert.Error(t, err)
+
+	_, err = parseSocketRequest(`{"payload": 1}`)
+	assert.Error(t, err)
+
+	network, address := parseSocketAddress("tcp", "udp://localhost:9000/")
+	assert.Equal(t, "udp", network)
+	assert.Equal(t, "localhost:9000", address)
+}
@@ -73,7 +73,7 @@ func (w *grpcResultWriter) Output(result []ReportResult) (err error) {
 	if err != nil {
 		log.Fatalln(err)
 	}
-	log.Println("getting response back:", resp)
+	log.Println("getting response back:", resp.messages)
 	return
 }
 
@@ -85,7 +85,7 @@ func (w *grpcResultWriter) getMethodDescriptor(conn *grpc.ClientConn) (protorefl
 	}
 	var dp protoreflect.Descriptor
 
-	dp, err = getByReflect(w.context, nil, fullName, conn)
+	dp, err = findByReflect(w.context, fullName, conn)
 	if err != nil {
 		return nil, err
 	}
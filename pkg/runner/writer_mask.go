@@ -0,0 +1,60 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"io"
+
+	"github.com/linuxsuren/api-testing/pkg/apispec"
+	"github.com/linuxsuren/api-testing/pkg/render"
+)
+
+type maskResultWriter struct {
+	writer ReportResultWriter
+}
+
+// NewMaskResultWriter creates a writer which masks the secret values before writing to the target writer
+func NewMaskResultWriter(writer ReportResultWriter) ReportResultWriter {
+	return &maskResultWriter{writer: writer}
+}
+
+// Output masks the secret values, then writes the report to target writer
+func (w *maskResultWriter) Output(results []ReportResult) error {
+	masked := make([]ReportResult, len(results))
+	for i, result := range results {
+		result.Name = render.MaskSecrets(result.Name)
+		result.API = render.MaskSecrets(result.API)
+		result.LastErrorMessage = render.MaskSecrets(result.LastErrorMessage)
+		masked[i] = result
+	}
+	return w.writer.Output(masked)
+}
+
+// WithAPICoverage sets the api coverage
+func (w *maskResultWriter) WithAPICoverage(apiCoverage apispec.APICoverage) ReportResultWriter {
+	w.writer.WithAPICoverage(apiCoverage)
+	return w
+}
+
+func (w *maskResultWriter) WithResourceUsage(usage []ResourceUsage) ReportResultWriter {
+	w.writer.WithResourceUsage(usage)
+	return w
+}
+
+func (w *maskResultWriter) GetWriter() io.Writer {
+	return render.NewMaskWriter(w.writer.GetWriter())
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/pkg/render"
+	"github.com/linuxsuren/api-testing/pkg/runner"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaskResultWriter(t *testing.T) {
+	render.AddSecretValue("mask-writer-secret")
+
+	buf := new(bytes.Buffer)
+	writer := runner.NewMaskResultWriter(runner.NewJSONResultWriter(buf))
+	assert.NotNil(t, writer.WithAPICoverage(nil))
+	assert.NotNil(t, writer.WithResourceUsage(nil))
+
+	err := writer.Output([]runner.ReportResult{{
+		Name:             "foo",
+		API:              "http://foo?token=mask-writer-secret",
+		LastErrorMessage: "invalid token mask-writer-secret",
+	}})
+	assert.NoError(t, err)
+	assert.NotContains(t, buf.String(), "mask-writer-secret")
+	assert.Contains(t, buf.String(), "http://foo?token=******")
+
+	buf.Reset()
+	fmt.Fprint(writer.GetWriter(), "token: mask-writer-secret")
+	assert.Equal(t, "token: ******", buf.String())
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// EnvMasterKey is the environment variable of the master key, it has higher priority than the key file
+	EnvMasterKey = "ATEST_SECRET_KEY"
+	// MasterKeyFile is the name of the master key file in the config directory
+	MasterKeyFile = "secret.key"
+
+	encryptedPrefix = "enc:"
+)
+
+// Cipher encrypts and decrypts the secret values
+type Cipher interface {
+	Encrypt(text string) (string, error)
+	Decrypt(text string) (string, error)
+}
+
+// Rotator re-encrypts the secret values with a new cipher
+type Rotator interface {
+	RotateSecret(oldCipher, newCipher Cipher) (err error)
+}
+
+// NewCipher creates a cipher with the master key, the values will be kept as plain text if the key is empty
+func NewCipher(key string) Cipher {
+	if key == "" {
+		return &plainCipher{}
+	}
+	sum := sha256.Sum256([]byte(key))
+	return &aesCipher{key: sum[:]}
+}
+
+// GetCipher returns the cipher with the master key of the config directory
+func GetCipher(configDir string) (c Cipher, err error) {
+	var key string
+	if key, err = GetMasterKey(configDir); err == nil {
+		c = NewCipher(key)
+	}
+	return
+}
+
+// GetMasterKey returns the master key from the environment variable or the key file.
+// It returns an empty string without error if there is no master key.
+func GetMasterKey(configDir string) (key string, err error) {
+	if key = os.Getenv(EnvMasterKey); key != "" {
+		return
+	}
+
+	var data []byte
+	if data, err = os.ReadFile(filepath.Join(configDir, MasterKeyFile)); err == nil {
+		key = strings.TrimSpace(string(data))
+	} else if errors.Is(err, os.ErrNotExist) {
+		err = nil
+	}
+	return
+}
+
+// InitMasterKey generates the master key file if there is no master key
+func InitMasterKey(configDir string) (err error) {
+	var key string
+	if key, err = GetMasterKey(configDir); err == nil && key == "" {
+		if key, err = GenerateMasterKey(); err == nil {
+			err = SaveMasterKey(configDir, key)
+		}
+	}
+	return
+}
+
+// GenerateMasterKey generates a random master key
+func GenerateMasterKey() (key string, err error) {
+	data := make([]byte, 32)
+	if _, err = rand.Read(data); err == nil {
+		key = base64.StdEncoding.EncodeToString(data)
+	}
+	return
+}
+
+// SaveMasterKey writes the master key into the key file of the config directory
+func SaveMasterKey(configDir, key string) (err error) {
+	if err = os.MkdirAll(configDir, 0755); err == nil {
+		err = os.WriteFile(filepath.Join(configDir, MasterKeyFile), []byte(key), 0600)
+	}
+	return
+}
+
+// IsEncrypted checks if the value was encrypted
+func IsEncrypted(text string) bool {
+	return strings.HasPrefix(text, encryptedPrefix)
+}
+
+type plainCipher struct{}
+
+func (c *plainCipher) Encrypt(text string) (string, error) {
+	return text, nil
+}
+
+func (c *plainCipher) Decrypt(text string) (result string, err error) {
+	if IsEncrypted(text) {
+		err = errors.New("the value was encrypted, but there is no master key")
+	} else {
+		result = text
+	}
+	return
+}
+
+type aesCipher struct {
+	key []byte
+}
+
+func (c *aesCipher) Encrypt(text string) (result string, err error) {
+	if text == "" || IsEncrypted(text) {
+		result = text
+		return
+	}
+
+	var gcm cipher.AEAD
+	if gcm, err = c.getGCM(); err != nil {
+		return
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err == nil {
+		data := gcm.Seal(nonce, nonce, []byte(text), nil)
+		result = encryptedPrefix + base64.StdEncoding.EncodeToString(data)
+	}
+	return
+}
+
+// Decrypt returns the plain text directly if it was not encrypted
+func (c *aesCipher) Decrypt(text string) (result string, err error) {
+	if !IsEncrypted(text) {
+		result = text
+		return
+	}
+
+	var data []byte
+	if data, err = base64.StdEncoding.DecodeString(strings.TrimPrefix(text, encryptedPrefix)); err != nil {
+		return
+	}
+
+	var gcm cipher.AEAD
+	if gcm, err = c.getGCM(); err != nil {
+		return
+	}
+
+	if len(data) < gcm.NonceSize() {
+		err = errors.New("invalid encrypted value")
+		return
+	}
+
+	var plain []byte
+	nonce, cipherText := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	if plain, err = gcm.Open(nil, nonce, cipherText, nil); err != nil {
+		err = fmt.Errorf("failed to decrypt the value, the master key might be wrong: %v", err)
+	} else {
+		result = string(plain)
+	}
+	return
+}
+
+func (c *aesCipher) getGCM() (gcm cipher.AEAD, err error) {
+	var block cipher.Block
+	if block, err = aes.NewCipher(c.key); err == nil {
+		gcm, err = cipher.NewGCM(block)
+	}
+	return
+}
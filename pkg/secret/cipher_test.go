@@ -0,0 +1,108 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCipher(t *testing.T) {
+	c := NewCipher("key")
+
+	encrypted, err := c.Encrypt("value")
+	assert.NoError(t, err)
+	assert.True(t, IsEncrypted(encrypted))
+	assert.NotContains(t, encrypted, "value")
+
+	var again string
+	again, err = c.Encrypt(encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, encrypted, again, "should not encrypt twice")
+
+	var plain string
+	plain, err = c.Decrypt(encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, "value", plain)
+
+	plain, err = c.Decrypt("plain")
+	assert.NoError(t, err)
+	assert.Equal(t, "plain", plain)
+
+	_, err = NewCipher("wrong").Decrypt(encrypted)
+	assert.Error(t, err)
+
+	_, err = c.Decrypt("enc:invalid")
+	assert.Error(t, err)
+
+	_, err = c.Decrypt("enc:")
+	assert.Error(t, err)
+
+	t.Run("plain cipher", func(t *testing.T) {
+		plainCipher := NewCipher("")
+		result, err := plainCipher.Encrypt("value")
+		assert.NoError(t, err)
+		assert.Equal(t, "value", result)
+
+		result, err = plainCipher.Decrypt("value")
+		assert.NoError(t, err)
+		assert.Equal(t, "value", result)
+
+		_, err = plainCipher.Decrypt(encrypted)
+		assert.Error(t, err)
+	})
+}
+
+func TestMasterKey(t *testing.T) {
+	configDir := t.TempDir()
+
+	key, err := GetMasterKey(configDir)
+	assert.NoError(t, err)
+	assert.Empty(t, key)
+
+	assert.NoError(t, InitMasterKey(configDir))
+	key, err = GetMasterKey(configDir)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, key)
+
+	var info os.FileInfo
+	info, err = os.Stat(filepath.Join(configDir, MasterKeyFile))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// the existing key should not be changed
+	assert.NoError(t, InitMasterKey(configDir))
+	var newKey string
+	newKey, err = GetMasterKey(configDir)
+	assert.NoError(t, err)
+	assert.Equal(t, key, newKey)
+
+	t.Run("from env", func(t *testing.T) {
+		t.Setenv(EnvMasterKey, "env-key")
+		key, err := GetMasterKey(configDir)
+		assert.NoError(t, err)
+		assert.Equal(t, "env-key", key)
+
+		var c Cipher
+		c, err = GetCipher(configDir)
+		assert.NoError(t, err)
+		assert.Equal(t, NewCipher("env-key"), c)
+	})
+}
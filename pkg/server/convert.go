@@ -17,7 +17,9 @@ package server
 
 import (
 	"strings"
+	"time"
 
+	"github.com/linuxsuren/api-testing/pkg/mock"
 	"github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/linuxsuren/api-testing/pkg/util"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -95,6 +97,7 @@ func ConvertToGRPCHistoryTestCase(historyTestcase testing.HistoryTestCase) (resu
 			Body:             res.Body,
 			StatusCode:       int32(res.StatusCode),
 			Schema:           res.Schema,
+			GrpcCode:         res.GRPCCode,
 			Verify:           res.Verify,
 			Header:           mapToPair(res.Header),
 			BodyFieldsExpect: mapInterToPair(res.BodyFieldsExpect),
@@ -128,6 +131,7 @@ func ToGRPCSuite(suite *testing.TestSuite) (result *TestSuite) {
 			Ca:         suite.Spec.Secure.CAFile,
 			ServerName: suite.Spec.Secure.ServerName,
 			Key:        suite.Spec.Secure.KeyFile,
+			MinVersion: suite.Spec.Secure.MinVersion,
 		}
 	}
 	if suite.Spec.RPC != nil {
@@ -137,6 +141,8 @@ func ToGRPCSuite(suite *testing.TestSuite) (result *TestSuite) {
 			Protofile:        suite.Spec.RPC.ProtoFile,
 			Protoset:         suite.Spec.RPC.ProtoSet,
 			Raw:              suite.Spec.RPC.Raw,
+			Protocol:         suite.Spec.RPC.Protocol,
+			Credentials:      ToGRPCCredentials(suite.Spec.RPC.Credentials),
 		}
 	}
 	return
@@ -167,6 +173,7 @@ func ToNormalSuite(suite *TestSuite) (result *testing.TestSuite) {
 				CAFile:     suite.Spec.Secure.Ca,
 				ServerName: suite.Spec.Secure.ServerName,
 				KeyFile:    suite.Spec.Secure.Key,
+				MinVersion: suite.Spec.Secure.MinVersion,
 			}
 		}
 		if suite.Spec.Rpc != nil {
@@ -176,6 +183,8 @@ func ToNormalSuite(suite *TestSuite) (result *testing.TestSuite) {
 				ProtoSet:         suite.Spec.Rpc.Protoset,
 				ImportPath:       suite.Spec.Rpc.Import,
 				ServerReflection: suite.Spec.Rpc.ServerReflection,
+				Protocol:         suite.Spec.Rpc.Protocol,
+				Credentials:      ToNormalCredentials(suite.Spec.Rpc.Credentials),
 			}
 		}
 	}
@@ -205,6 +214,7 @@ func ToGRPCTestCase(testCase testing.TestCase) (result *TestCase) {
 		BodyFieldsExpect: mapInterToPair(testCase.Expect.BodyFieldsExpect),
 		Verify:           testCase.Expect.Verify,
 		Schema:           testCase.Expect.Schema,
+		GrpcCode:         testCase.Expect.GRPCCode,
 	}
 
 	result = &TestCase{
@@ -212,6 +222,22 @@ func ToGRPCTestCase(testCase testing.TestCase) (result *TestCase) {
 		Request:  req,
 		Response: resp,
 	}
+	for _, capture := range testCase.Capture {
+		result.Capture = append(result.Capture, &Capture{
+			Name:       capture.Name,
+			Type:       capture.Type,
+			Expression: capture.Expression,
+		})
+	}
+	for _, step := range testCase.Stream {
+		result.Stream = append(result.Stream, &StreamStep{
+			Send:      step.Send,
+			Expect:    step.Expect,
+			Wait:      step.Wait,
+			CloseSend: step.CloseSend,
+			Timeout:   step.Timeout,
+		})
+	}
 	return
 }
 
@@ -235,12 +261,30 @@ func ToNormalTestCase(in *TestCase) (result testing.TestCase) {
 	if resp != nil {
 		result.Expect.Body = strings.TrimSpace(resp.Body)
 		result.Expect.Schema = strings.TrimSpace(resp.Schema)
+		result.Expect.GRPCCode = strings.TrimSpace(resp.GrpcCode)
 		result.Expect.StatusCode = int(resp.StatusCode)
 		result.Expect.Verify = util.RemoeEmptyFromSlice(resp.Verify)
 		result.Expect.ConditionalVerify = convertConditionalVerify(resp.ConditionalVerify)
 		result.Expect.BodyFieldsExpect = pairToInterMap(resp.BodyFieldsExpect)
 		result.Expect.Header = pairToMap(resp.Header)
 	}
+
+	for _, capture := range in.Capture {
+		result.Capture = append(result.Capture, testing.Capture{
+			Name:       capture.Name,
+			Type:       capture.Type,
+			Expression: capture.Expression,
+		})
+	}
+	for _, step := range in.Stream {
+		result.Stream = append(result.Stream, testing.StreamStep{
+			Send:      step.Send,
+			Expect:    step.Expect,
+			Wait:      step.Wait,
+			CloseSend: step.CloseSend,
+			Timeout:   step.Timeout,
+		})
+	}
 	return
 }
 
@@ -291,6 +335,8 @@ func ToGRPCTestSuiteSpec(spec testing.APISpec) (result *APISpec) {
 			Protofile:        spec.RPC.ProtoFile,
 			Import:           spec.RPC.ImportPath,
 			ServerReflection: spec.RPC.ServerReflection,
+			Protocol:         spec.RPC.Protocol,
+			Credentials:      ToGRPCCredentials(spec.RPC.Credentials),
 		}
 	}
 	if spec.Secure != nil {
@@ -300,7 +346,78 @@ func ToGRPCTestSuiteSpec(spec testing.APISpec) (result *APISpec) {
 			Ca:         spec.Secure.CAFile,
 			ServerName: spec.Secure.ServerName,
 			Key:        spec.Secure.KeyFile,
+			MinVersion: spec.Secure.MinVersion,
 		}
 	}
 	return
 }
+
+// ToGRPCCredentials converts the per-RPC credentials to the gRPC ones,
+// the templates are kept as they are, and rendered by the runner
+func ToGRPCCredentials(credentials []testing.RPCCredential) (result []*RPCCredential) {
+	for _, item := range credentials {
+		result = append(result, &RPCCredential{
+			Type:         item.Type,
+			Token:        item.Token,
+			Metadata:     mapToPair(item.Metadata),
+			TokenURL:     item.TokenURL,
+			ClientID:     item.ClientID,
+			ClientSecret: item.ClientSecret,
+			Scopes:       item.Scopes,
+			Secret:       item.Secret,
+			Key:          item.KeyFile,
+			Claims:       mapToPair(item.Claims),
+			Ttl:          item.TTL,
+		})
+	}
+	return
+}
+
+// ToNormalCredentials converts the gRPC per-RPC credentials to the normal ones
+func ToNormalCredentials(credentials []*RPCCredential) (result []testing.RPCCredential) {
+	for _, item := range credentials {
+		result = append(result, testing.RPCCredential{
+			Type:         item.Type,
+			Token:        item.Token,
+			Metadata:     pairToMap(item.Metadata),
+			TokenURL:     item.TokenURL,
+			ClientID:     item.ClientID,
+			ClientSecret: item.ClientSecret,
+			Scopes:       item.Scopes,
+			Secret:       item.Secret,
+			KeyFile:      item.Key,
+			Claims:       pairToMap(item.Claims),
+			TTL:          item.Ttl,
+		})
+	}
+	return
+}
+
+// ToNormalJournalFilter converts the gRPC journal filter to the mock one
+func ToNormalJournalFilter(filter *MockJournalFilter) mock.JournalFilter {
+	return mock.JournalFilter{
+		Kind:   filter.Kind,
+		Item:   filter.Item,
+		Method: filter.Method,
+		Path:   filter.Path,
+		Body:   filter.Body,
+		Limit:  int(filter.Limit),
+	}
+}
+
+// ToGRPCJournalEntry converts the mock journal entry to the gRPC one
+func ToGRPCJournalEntry(entry mock.JournalEntry) *MockJournalEntry {
+	return &MockJournalEntry{
+		Time:    entry.Time.Format(time.RFC3339Nano),
+		Method:  entry.Method,
+		Path:    entry.Path,
+		Query:   entry.Query,
+		Header:  mapToPair(entry.Header),
+		Body:    entry.Body,
+		Item:    entry.Item,
+		Status:  int32(entry.Status),
+		Kind:    entry.Kind,
+		Error:   entry.Error,
+		Attempt: int32(entry.Attempt),
+	}
+}
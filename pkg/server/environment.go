@@ -0,0 +1,139 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/linuxsuren/api-testing/pkg/render"
+	"github.com/linuxsuren/api-testing/pkg/secret"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ToGRPCEnvironment converts the normal environment to the gRPC one
+func ToGRPCEnvironment(env testing.Environment) *Environment {
+	return &Environment{
+		Name:      env.Name,
+		Variables: mapToPair(env.Variables),
+		Secrets:   mapToPair(env.Secrets),
+	}
+}
+
+// ToNormalEnvironment converts the gRPC environment to the normal one
+func ToNormalEnvironment(env *Environment) testing.Environment {
+	return testing.Environment{
+		Name:      env.Name,
+		Variables: pairToMap(env.Variables),
+		Secrets:   pairToMap(env.Secrets),
+	}
+}
+
+type serverSecretGetter struct {
+	ctx          context.Context
+	secretServer SecretServiceServer
+}
+
+func (g *serverSecretGetter) GetSecret(name string) (reply secret.Secret, err error) {
+	getter, ok := g.secretServer.(SecertServiceGetable)
+	if !ok {
+		err = errNoSecretService
+		return
+	}
+
+	var result *Secret
+	if result, err = getter.GetSecret(g.ctx, &Secret{Name: name}); err == nil && result != nil {
+		reply.Name = result.Name
+		reply.Value = result.Value
+	}
+	return
+}
+
+// getEnvVariables returns the variables of the given environment, the extra ones have lower priority
+func (s *server) getEnvVariables(ctx context.Context, name string, extra map[string]string) (result map[string]string, err error) {
+	result = map[string]string{}
+	// the extra variables, such as the env of a test task, have lower priority than the named environment
+	for key, val := range extra {
+		result[key] = val
+	}
+
+	if name != "" {
+		var env *testing.Environment
+		if env, err = s.envFactory.GetEnvironment(name); err != nil {
+			return
+		}
+
+		var variables map[string]string
+		if variables, err = env.Resolve(render.NewMaskSecretGetter(&serverSecretGetter{
+			ctx:          ctx,
+			secretServer: s.secretServer,
+		})); err != nil {
+			return
+		}
+		for key, val := range variables {
+			result[key] = val
+		}
+	}
+	return
+}
+
+func (s *server) GetEnvironments(ctx context.Context, in *Empty) (reply *Environments, err error) {
+	var envs []testing.Environment
+	if envs, err = s.envFactory.GetEnvironments(); err == nil {
+		reply = &Environments{}
+		for _, env := range envs {
+			reply.Data = append(reply.Data, ToGRPCEnvironment(env))
+		}
+	}
+	return
+}
+
+func (s *server) CreateEnvironment(ctx context.Context, in *Environment) (reply *Environment, err error) {
+	if in.Name == "" {
+		err = errors.New("name is required")
+		return
+	}
+
+	if err = s.envFactory.CreateEnvironment(ToNormalEnvironment(in)); err == nil {
+		reply = in
+	}
+	return
+}
+
+func (s *server) UpdateEnvironment(ctx context.Context, in *Environment) (reply *Environment, err error) {
+	if err = s.envFactory.UpdateEnvironment(ToNormalEnvironment(in)); err == nil {
+		reply = in
+	}
+	err = toEnvironmentStatusError(err)
+	return
+}
+
+func (s *server) DeleteEnvironment(ctx context.Context, in *Environment) (reply *Environment, err error) {
+	reply = &Environment{}
+	err = toEnvironmentStatusError(s.envFactory.DeleteEnvironment(in.Name))
+	return
+}
+
+// toEnvironmentStatusError converts the not found error of an environment to the gRPC status
+func toEnvironmentStatusError(err error) error {
+	if errors.Is(err, testing.ErrEnvironmentNotFound) {
+		err = status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
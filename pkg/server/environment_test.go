@@ -0,0 +1,142 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/h2non/gock"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const envSuite = `name: env
+api: "{{.env.SERVER}}"
+items:
+- name: get
+  request:
+    api: /{{.env.PATH}}
+`
+
+func TestEnvironmentRPCs(t *testing.T) {
+	ctx := context.Background()
+	remoteServer := NewRemoteServer(atest.NewFileWriter(""), nil, nil, nil, t.TempDir(), 1024*1024*4)
+
+	_, err := remoteServer.CreateEnvironment(ctx, &Environment{})
+	assert.Error(t, err)
+
+	var env *Environment
+	env, err = remoteServer.CreateEnvironment(ctx, &Environment{
+		Name:      "staging",
+		Variables: []*Pair{{Key: "SERVER", Value: urlFoo}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "staging", env.Name)
+
+	_, err = remoteServer.CreateEnvironment(ctx, &Environment{
+		Name:    "prod",
+		Secrets: []*Pair{{Key: "TOKEN", Value: "token"}},
+	})
+	assert.NoError(t, err)
+
+	var envs *Environments
+	envs, err = remoteServer.GetEnvironments(ctx, &Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(envs.Data))
+
+	_, err = remoteServer.UpdateEnvironment(ctx, &Environment{
+		Name:      "staging",
+		Variables: []*Pair{{Key: "SERVER", Value: urlFoo}, {Key: "PATH", Value: "bar"}},
+	})
+	assert.NoError(t, err)
+	_, err = remoteServer.UpdateEnvironment(ctx, &Environment{Name: "fake"})
+	assert.Error(t, err)
+
+	t.Run("run with environment", func(t *testing.T) {
+		defer gock.Off()
+		gock.New(urlFoo).Get("/bar").Reply(http.StatusOK).JSON(`{}`)
+
+		result, err := remoteServer.Run(ctx, &TestTask{
+			Kind:        "suite",
+			Data:        envSuite,
+			Environment: "staging",
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, result.Error)
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("the environment has higher priority than the task env", func(t *testing.T) {
+		defer gock.Off()
+		gock.New(urlFoo).Get("/bar").Reply(http.StatusOK).JSON(`{}`)
+
+		result, err := remoteServer.Run(ctx, &TestTask{
+			Kind:        "suite",
+			Data:        envSuite,
+			Environment: "staging",
+			Env:         map[string]string{"PATH": "foo"},
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, result.Error)
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("the task env is visible to the env function", func(t *testing.T) {
+		defer gock.Off()
+		gock.New(urlFoo).Get("/foo").Reply(http.StatusOK).JSON(`{}`)
+
+		result, err := remoteServer.Run(ctx, &TestTask{
+			Kind: "suite",
+			Data: `name: env
+api: '{{env "TASK_SERVER"}}'
+items:
+- name: get
+  request:
+    api: /{{.env.PATH}}
+`,
+			Env: map[string]string{"TASK_SERVER": urlFoo, "PATH": "foo"},
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, result.Error)
+		assert.True(t, gock.IsDone())
+		assert.Empty(t, os.Getenv("TASK_SERVER"))
+	})
+
+	t.Run("environment not found", func(t *testing.T) {
+		_, err := remoteServer.Run(ctx, &TestTask{Kind: "suite", Data: envSuite, Environment: "fake"})
+		assert.Error(t, err)
+	})
+
+	t.Run("no secret server", func(t *testing.T) {
+		_, err := remoteServer.Run(ctx, &TestTask{Kind: "suite", Data: envSuite, Environment: "prod"})
+		assert.Error(t, err)
+	})
+
+	_, err = remoteServer.DeleteEnvironment(ctx, &Environment{Name: "staging"})
+	assert.NoError(t, err)
+	envs, err = remoteServer.GetEnvironments(ctx, &Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(envs.Data))
+
+	_, err = remoteServer.DeleteEnvironment(ctx, &Environment{Name: "staging"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -36,6 +36,7 @@ import (
 	"time"
 
 	"github.com/expr-lang/expr/builtin"
+	"github.com/google/uuid"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -78,6 +79,9 @@ type server struct {
 	storeExtMgr        ExtManager
 
 	secretServer SecretServiceServer
+	scheduler    *testScheduler
+	notifier     runner.Notifier
+	envFactory   testing.EnvironmentGetterAndSetter
 
 	grpcMaxRecvMsgSize int
 }
@@ -124,7 +128,7 @@ func NewRemoteServer(loader testing.Writer, storeWriterFactory testing.StoreWrit
 	}
 	loader.WithUserConfigDir(configDir)
 	GrpcMaxRecvMsgSize = grpcMaxRecvMsgSize
-	return &server{
+	s := &server{
 		loader:             loader,
 		storeWriterFactory: storeWriterFactory,
 		configDir:          configDir,
@@ -132,6 +136,10 @@ func NewRemoteServer(loader testing.Writer, storeWriterFactory testing.StoreWrit
 		storeExtMgr:        storeExtMgr,
 		grpcMaxRecvMsgSize: grpcMaxRecvMsgSize,
 	}
+	s.scheduler = newTestScheduler(testing.NewScheduleFactory(configDir), s.runSchedule)
+	s.notifier = runner.NewNotifier(filepath.Join(configDir, "notifications.yaml"))
+	s.envFactory = testing.NewEnvironmentFactory(configDir)
+	return s
 }
 
 func withDefaultValue(old, defVal any) any {
@@ -188,12 +196,6 @@ func (s *server) getSuiteFromTestTask(task *TestTask) (suite *testing.TestSuite,
 	return
 }
 
-func resetEnv(oldEnv map[string]string) {
-	for key, val := range oldEnv {
-		os.Setenv(key, val)
-	}
-}
-
 func (s *server) getLoaders() (loader []testing.Writer, err error) {
 	var stores []testing.Store
 	if stores, err = testing.NewStoreFactory(s.configDir).GetStores(); err != nil {
@@ -234,28 +236,29 @@ func (s *server) getLoader(ctx context.Context) (loader testing.Writer) {
 
 // Run start to run the test task
 func (s *server) Run(ctx context.Context, task *TestTask) (reply *TestResult, err error) {
+	// the connections and caches are shared by the cases of this run only
+	ctx, closeScope := runner.WithRunScope(ctx)
+	defer closeRunScope(closeScope)
+
 	task.Level = withDefaultValue(task.Level, "info").(string)
 	task.Env = withDefaultValue(task.Env, map[string]string{}).(map[string]string)
 
 	var suite *testing.TestSuite
-	// TODO may not safe in multiple threads
-	oldEnv := map[string]string{}
-	for key, val := range task.Env {
-		oldEnv[key] = os.Getenv(key)
-		os.Setenv(key, val)
+	if suite, err = s.getSuiteFromTestTask(task); err != nil {
+		return
 	}
 
-	defer func() {
-		resetEnv(oldEnv)
-	}()
-
-	if suite, err = s.getSuiteFromTestTask(task); err != nil {
+	// the environment variables are only visible to the current run instead of the process
+	var envVariables map[string]string
+	if envVariables, err = s.getEnvVariables(ctx, task.Environment, task.Env); err != nil {
 		return
 	}
 
 	remoteServerLogger.Info("prepare to run", "name", suite.Name, " with level: ", task.Level)
 	remoteServerLogger.Info("task kind to run", "kind", task.Kind, "lens", len(suite.Items))
-	dataContext := map[string]interface{}{}
+	dataContext := map[string]interface{}{
+		testing.ContextKeyEnv: envVariables,
+	}
 
 	if err = suite.Render(dataContext); err != nil {
 		reply.Error = err.Error()
@@ -268,11 +271,26 @@ func (s *server) Run(ctx context.Context, task *TestTask) (reply *TestResult, er
 	}
 
 	buf := new(bytes.Buffer)
+	// the secret values in the output are masked
+	writer := render.NewMaskWriter(buf)
 	reply = &TestResult{}
 
+	var summary *runner.RunSummary
+	if task.Kind == "suite" {
+		summary = runner.NewRunSummary(suite.Name)
+		defer func() {
+			summary.Finish(nil)
+			go func() {
+				if notifyErr := s.notifier.Notify(summary); notifyErr != nil {
+					remoteServerLogger.Info("failed to send notification", "error", notifyErr)
+				}
+			}()
+		}()
+	}
+
 	for _, testCase := range suite.Items {
 		suiteRunner := runner.GetTestSuiteRunner(suite)
-		suiteRunner.WithOutputWriter(buf)
+		suiteRunner.WithOutputWriter(writer)
 		suiteRunner.WithWriteLevel(task.Level)
 		suiteRunner.WithSecure(suite.Spec.Secure)
 		suiteRunner.WithSuite(suite)
@@ -285,35 +303,45 @@ func (s *server) Run(ctx context.Context, task *TestTask) (reply *TestResult, er
 		}
 
 		output, testErr := suiteRunner.RunTestCase(&testCase, dataContext, ctx)
+		_ = render.FlushMaskWriter(writer)
+		var historyID string
 		if getter, ok := suiteRunner.(runner.ResponseRecord); ok {
 			resp := getter.GetResponseRecord()
 			//resp, err = runner.HandleLargeResponseBody(resp, suite.Name, testCase.Name)
-			reply.TestCaseResult = append(reply.TestCaseResult, &TestCaseResult{
+			testCaseResult := &TestCaseResult{
 				StatusCode: int32(resp.StatusCode),
 				Body:       resp.Body,
 				Header:     mapToPair(resp.Header),
 				Id:         testCase.ID,
 				Output:     buf.String(),
-			})
+			}
+			if testErr != nil {
+				testCaseResult.Error = render.MaskSecrets(testErr.Error())
+			}
+			reply.TestCaseResult = append(reply.TestCaseResult, testCaseResult)
+
+			// create history record, the failed one is included
+			historyResult := maskTestCaseResult(ToNormalTestCaseResult(testCaseResult))
+			historyResult.HistoryID = uuid.NewString()
+			historyID = historyResult.HistoryID
+			go func(historyResult testing.TestCaseResult, historyHeader map[string]string) {
+				loader := s.getLoader(ctx)
+				defer loader.Close()
+				if historyErr := loader.CreateHistoryTestCase(historyResult, suite, historyHeader); historyErr != nil {
+					remoteServerLogger.Info("error create history", "error", historyErr)
+				}
+			}(historyResult, historyHeader)
+		}
+		if summary != nil {
+			summary.AddCase(testCase.Name, historyID, testErr)
 		}
 
 		if testErr == nil {
 			dataContext[testCase.Name] = output
 		} else {
-			reply.Error = testErr.Error()
+			reply.Error = render.MaskSecrets(testErr.Error())
 			break
 		}
-		// create history record
-		go func(historyHeader map[string]string) {
-			loader := s.getLoader(ctx)
-			defer loader.Close()
-			for _, testCaseResult := range reply.TestCaseResult {
-				err = loader.CreateHistoryTestCase(ToNormalTestCaseResult(testCaseResult), suite, historyHeader)
-				if err != nil {
-					remoteServerLogger.Info("error create history")
-				}
-			}
-		}(historyHeader)
 	}
 
 	if reply.Error != "" {
@@ -323,8 +351,24 @@ func (s *server) Run(ctx context.Context, task *TestTask) (reply *TestResult, er
 	return
 }
 
+func closeRunScope(closeScope func() error) {
+	if err := closeScope(); err != nil {
+		remoteServerLogger.Info("failed to close the connections of the run", "error", err)
+	}
+}
+
+// maskTestCaseResult hides the secret values before storing the result as a history record
+func maskTestCaseResult(result testing.TestCaseResult) testing.TestCaseResult {
+	result.Body = render.MaskSecrets(result.Body)
+	result.Error = render.MaskSecrets(result.Error)
+	result.Output = render.MaskSecrets(result.Output)
+	result.Header = render.MaskSecretsInMap(result.Header)
+	return result
+}
+
 func (s *server) BatchRun(srv Runner_BatchRunServer) (err error) {
-	ctx := srv.Context()
+	ctx, closeScope := runner.WithRunScope(srv.Context())
+	defer closeRunScope(closeScope)
 	for {
 		select {
 		case <-ctx.Done():
@@ -414,7 +458,8 @@ func (s *server) DownloadResponseFile(ctx context.Context, in *TestCase) (reply
 }
 
 func (s *server) RunTestSuite(srv Runner_RunTestSuiteServer) (err error) {
-	ctx := srv.Context()
+	ctx, closeScope := runner.WithRunScope(srv.Context())
+	defer closeRunScope(closeScope)
 	for {
 		select {
 		case <-ctx.Done():
@@ -1645,9 +1690,36 @@ func (s *mockServerController) Reload(ctx context.Context, in *MockConfig) (repl
 	} else {
 		s.mockWriter.Parse()
 	}
-	err = s.loader.Load()
+	if err = s.loader.Load(); err == nil {
+		err = s.setScenarioStates(in.Scenarios)
+	}
+	return
+}
+
+// setScenarioStates changes the states of the given scenarios
+func (s *mockServerController) setScenarioStates(scenarios []*MockScenario) (err error) {
+	if len(scenarios) == 0 {
+		return
+	}
+
+	manager, ok := s.loader.(mock.ScenarioManager)
+	if !ok {
+		err = errors.New("scenarios are not supported by the mock server")
+		return
+	}
+	for _, scenario := range scenarios {
+		if scenario.State == "" {
+			err = manager.ResetScenarios(scenario.Name)
+		} else {
+			err = manager.SetScenarioState(scenario.Name, scenario.State)
+		}
+		if err != nil {
+			return
+		}
+	}
 	return
 }
+
 func (s *mockServerController) GetConfig(ctx context.Context, in *Empty) (reply *MockConfig, err error) {
 	reply = &MockConfig{
 		Prefix:         s.prefix,
@@ -1662,8 +1734,74 @@ func (s *mockServerController) GetConfig(ctx context.Context, in *Empty) (reply
 			reply.Port = int32(port)
 		}
 	}
+	if manager, ok := s.loader.(mock.ScenarioManager); ok {
+		for _, scenario := range manager.GetScenarios() {
+			reply.Scenarios = append(reply.Scenarios, &MockScenario{
+				Name:         scenario.Name,
+				State:        scenario.State,
+				InitialState: scenario.InitialState,
+				States:       scenario.States,
+			})
+		}
+	}
+	return
+}
+func (s *mockServerController) getJournalReader() (reader mock.JournalReader, err error) {
+	var ok bool
+	if reader, ok = s.loader.(mock.JournalReader); !ok {
+		err = errors.New("journal is not supported by the mock server")
+	}
 	return
 }
+
+func (s *mockServerController) GetJournal(ctx context.Context, in *MockJournalFilter) (reply *MockJournal, err error) {
+	var reader mock.JournalReader
+	if reader, err = s.getJournalReader(); err != nil {
+		return
+	}
+
+	var entries []mock.JournalEntry
+	if entries, err = reader.GetJournal(ToNormalJournalFilter(in)); err == nil {
+		reply = &MockJournal{}
+		for _, entry := range entries {
+			reply.Data = append(reply.Data, ToGRPCJournalEntry(entry))
+		}
+	}
+	return
+}
+
+func (s *mockServerController) ResetJournal(ctx context.Context, in *Empty) (reply *Empty, err error) {
+	var reader mock.JournalReader
+	if reader, err = s.getJournalReader(); err == nil {
+		reader.ResetJournal()
+		reply = &Empty{}
+	}
+	return
+}
+
+func (s *mockServerController) VerifyJournal(ctx context.Context, in *MockJournalFilter) (reply *MockJournalVerification, err error) {
+	var reader mock.JournalReader
+	if reader, err = s.getJournalReader(); err != nil {
+		return
+	}
+
+	var times *int
+	if in.Times != nil {
+		count := int(in.GetTimes())
+		times = &count
+	}
+
+	var result mock.JournalVerification
+	if result, err = reader.VerifyJournal(ToNormalJournalFilter(in), times); err == nil {
+		reply = &MockJournalVerification{
+			Success: result.Success,
+			Count:   int32(result.Count),
+			Message: result.Message,
+		}
+	}
+	return
+}
+
 func (s *mockServerController) LogWatch(e *Empty, logServer Mock_LogWatchServer) (err error) {
 	logServer.Send(&CommonResult{
 		Success: true,
@@ -1723,9 +1861,11 @@ func findParentTestCases(testcase *testing.TestCase, suite *testing.TestSuite) (
 		findExpectNames(testcase.Request.API, expectNames)
 		findExpectNames(testcase.Request.Body.String(), expectNames)
 
-		remoteServerLogger.Info("expect test case names", "name", expectNames.GetAll())
+		variableNames := findVariableNames(testcase)
+		remoteServerLogger.Info("expect test case names", "name", expectNames.GetAll(), "variables", variableNames.GetAll())
 		for _, item := range suite.Items {
-			if expectNames.Exist(item.Name) {
+			// a test case is not the parent of itself even if it captures the variables which it uses
+			if expectNames.Exist(item.Name) || (item.Name != testcase.Name && capturesAny(&item, variableNames)) {
 				testcases = append(testcases, item)
 			}
 		}
@@ -1733,6 +1873,36 @@ func findParentTestCases(testcase *testing.TestCase, suite *testing.TestSuite) (
 	return
 }
 
+var variableReferenceReg = regexp.MustCompile(`\.` + testing.ContextKeyVariables + `\.(\w+)`)
+
+// findVariableNames returns the names of the captured variables which are used by the test case
+func findVariableNames(testcase *testing.TestCase) (names *UniqueSlice[string]) {
+	names = new(UniqueSlice[string])
+	targets := []string{testcase.Request.API, testcase.Request.Body.String()}
+	for _, val := range testcase.Request.Header {
+		targets = append(targets, val)
+	}
+	for key := range testcase.Request.Query {
+		targets = append(targets, testcase.Request.Query.GetValue(key))
+	}
+
+	for _, target := range targets {
+		for _, matched := range variableReferenceReg.FindAllStringSubmatch(target, -1) {
+			names.Push(matched[1])
+		}
+	}
+	return
+}
+
+func capturesAny(testcase *testing.TestCase, names *UniqueSlice[string]) bool {
+	for _, name := range names.GetAll() {
+		if testcase.Captures(name) {
+			return true
+		}
+	}
+	return false
+}
+
 func findExpectNames(target string, expectNames *UniqueSlice[string]) {
 	reg, _ := regexp.Compile(`(.*?\{\{.*\.\w*.*?\}\})`)
 	targetReg, _ := regexp.Compile(`\.\w*`)
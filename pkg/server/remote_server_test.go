@@ -32,6 +32,7 @@ import (
 
 	"github.com/h2non/gock"
 	"github.com/linuxsuren/api-testing/pkg/mock"
+	"github.com/linuxsuren/api-testing/pkg/render"
 	atest "github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/linuxsuren/api-testing/pkg/util"
 	"github.com/linuxsuren/api-testing/sample"
@@ -284,6 +285,40 @@ func TestFindParentTestCases(t *testing.T) {
 				API: "/users",
 			},
 		}},
+	}, {
+		name: "captured variables",
+		testcase: &atest.TestCase{
+			Name: "user",
+			Request: atest.Request{
+				API: "/users/{{.vars.userID}}",
+				Header: map[string]string{
+					util.Authorization: "Bearer {{.vars.token}}",
+				},
+			},
+		},
+		suite: &atest.TestSuite{
+			Items: []atest.TestCase{{
+				Name:    "login",
+				Capture: []atest.Capture{{Name: "token", Type: atest.CaptureTypeJSON, Expression: "token"}},
+			}, {
+				Name:    "users",
+				Capture: []atest.Capture{{Name: "userID", Type: atest.CaptureTypeJSON, Expression: "0.id"}},
+			}, {
+				Name: "projects",
+			}, {
+				Name: "user",
+				Request: atest.Request{
+					API: "/users/{{.vars.userID}}",
+				},
+			}},
+		},
+		expect: []atest.TestCase{{
+			Name:    "login",
+			Capture: []atest.Capture{{Name: "token", Type: atest.CaptureTypeJSON, Expression: "token"}},
+		}, {
+			Name:    "users",
+			Capture: []atest.Capture{{Name: "userID", Type: atest.CaptureTypeJSON, Expression: "0.id"}},
+		}},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1166,6 +1201,82 @@ func TestMockServer(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "localFile", config.StoreKind)
 	})
+
+	t.Run("reload with scenarios", func(t *testing.T) {
+		mockConfig := &MockConfig{
+			StoreKind: "memory",
+			Config: `scenarios:
+  - name: order
+    states: [pending, done]
+items:
+  - name: order
+    scenario: order
+    requiredState: done
+    request:
+      path: /order
+    response:
+      body: done`,
+		}
+		_, err := mockServer.Reload(context.Background(), mockConfig)
+		assert.NoError(t, err)
+
+		config, err := mockServer.GetConfig(context.Background(), &Empty{})
+		assert.NoError(t, err)
+		if assert.Len(t, config.Scenarios, 1) {
+			assert.Equal(t, "pending", config.Scenarios[0].State)
+			assert.Equal(t, []string{"pending", "done"}, config.Scenarios[0].States)
+		}
+
+		mockConfig.Scenarios = []*MockScenario{{Name: "order", State: "done"}}
+		_, err = mockServer.Reload(context.Background(), mockConfig)
+		assert.NoError(t, err)
+		config, err = mockServer.GetConfig(context.Background(), &Empty{})
+		assert.NoError(t, err)
+		assert.Equal(t, "done", config.Scenarios[0].State)
+
+		mockConfig.Scenarios = []*MockScenario{{Name: "order", State: "fake"}}
+		_, err = mockServer.Reload(context.Background(), mockConfig)
+		assert.Error(t, err)
+	})
+
+	t.Run("journal", func(t *testing.T) {
+		_, err := mockServer.Reload(context.Background(), &MockConfig{
+			StoreKind: "memory",
+			Config: `items:
+  - name: order
+    request:
+      path: /order
+    response:
+      body: done`,
+		})
+		assert.NoError(t, err)
+
+		config, err := mockServer.GetConfig(context.Background(), &Empty{})
+		assert.NoError(t, err)
+		_, err = http.Get(fmt.Sprintf("http://localhost:%d/order", config.Port))
+		assert.NoError(t, err)
+
+		journal, err := mockServer.GetJournal(context.Background(), &MockJournalFilter{Item: "order"})
+		assert.NoError(t, err)
+		if assert.Len(t, journal.Data, 1) {
+			assert.Equal(t, "/order", journal.Data[0].Path)
+			assert.Equal(t, int32(http.StatusOK), journal.Data[0].Status)
+		}
+
+		times := int32(1)
+		result, err := mockServer.VerifyJournal(context.Background(), &MockJournalFilter{Item: "order", Times: &times})
+		assert.NoError(t, err)
+		assert.True(t, result.Success)
+
+		_, err = mockServer.ResetJournal(context.Background(), &Empty{})
+		assert.NoError(t, err)
+		result, err = mockServer.VerifyJournal(context.Background(), &MockJournalFilter{Item: "order"})
+		assert.NoError(t, err)
+		assert.False(t, result.Success)
+
+		_, err = mockServer.GetJournal(context.Background(), &MockJournalFilter{Body: "("})
+		assert.Error(t, err)
+	})
 }
 
 func TestUIExtension(t *testing.T) {
@@ -1200,3 +1311,21 @@ func TestUIExtension(t *testing.T) {
 		&SimpleName{Name: "name"})
 	assert.False(t, result.Success)
 }
+
+func TestMaskTestCaseResult(t *testing.T) {
+	render.AddSecretValue("history-secret")
+	result := maskTestCaseResult(atest.TestCaseResult{
+		StatusCode: http.StatusOK,
+		Body:       `{"token":"history-secret"}`,
+		Header:     map[string]string{"Authorization": "history-secret"},
+		Error:      "invalid history-secret",
+		Output:     "history-secret",
+	})
+	assert.Equal(t, atest.TestCaseResult{
+		StatusCode: http.StatusOK,
+		Body:       `{"token":"******"}`,
+		Header:     map[string]string{"Authorization": "******"},
+		Error:      "invalid ******",
+		Output:     "******",
+	}, result)
+}
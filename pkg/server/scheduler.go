@@ -0,0 +1,339 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/logging"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/robfig/cron/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+)
+
+var schedulerLogger = logging.DefaultLogger(logging.LogLevelInfo).WithName("scheduler")
+
+// SchedulerStarter represents a server which is able to run the scheduled test suites
+type SchedulerStarter interface {
+	StartScheduler(ctx context.Context) error
+}
+
+type scheduleStatus struct {
+	lastRunTime time.Time
+	lastError   string
+}
+
+type testScheduler struct {
+	factory testing.ScheduleGetterAndSetter
+	runFunc func(context.Context, testing.Schedule) error
+
+	ctx      context.Context
+	cron     *cron.Cron
+	entries  map[string]cron.EntryID
+	statuses map[string]*scheduleStatus
+	running  int
+	mu       sync.Mutex
+}
+
+func newTestScheduler(factory testing.ScheduleGetterAndSetter, runFunc func(context.Context, testing.Schedule) error) *testScheduler {
+	return &testScheduler{
+		factory:  factory,
+		runFunc:  runFunc,
+		ctx:      context.Background(),
+		cron:     cron.New(),
+		entries:  make(map[string]cron.EntryID),
+		statuses: make(map[string]*scheduleStatus),
+	}
+}
+
+// Start loads all the schedules, then starts the cron until the context is done
+func (t *testScheduler) Start(ctx context.Context) (err error) {
+	t.ctx = ctx
+	if err = t.reload(); err == nil {
+		t.cron.Start()
+		go func() {
+			<-ctx.Done()
+			t.cron.Stop()
+		}()
+	}
+	return
+}
+
+func (t *testScheduler) reload() (err error) {
+	var schedules []testing.Schedule
+	if schedules, err = t.factory.GetSchedules(); err != nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for name, id := range t.entries {
+		t.cron.Remove(id)
+		delete(t.entries, name)
+	}
+
+	for _, schedule := range schedules {
+		if schedule.Disabled {
+			continue
+		}
+
+		item := schedule
+		var id cron.EntryID
+		if id, err = t.cron.AddFunc(item.Cron, func() {
+			t.run(item)
+		}); err != nil {
+			err = fmt.Errorf("invalid cron %q of schedule %s: %v", item.Cron, item.Name, err)
+			return
+		}
+		t.entries[item.Name] = id
+	}
+	return
+}
+
+func (t *testScheduler) run(schedule testing.Schedule) {
+	config, err := t.factory.GetScheduleConfig()
+	if err != nil {
+		schedulerLogger.Info("failed to get schedule config", "error", err)
+		return
+	}
+	if config.Paused {
+		schedulerLogger.Info("skip schedule due to the scheduler was paused", "name", schedule.Name)
+		return
+	}
+
+	if !t.acquire(getMaxConcurrency(config)) {
+		schedulerLogger.Info("skip schedule due to reaching the max concurrency", "name", schedule.Name)
+		t.setStatus(schedule.Name, errors.New("skipped due to reaching the max concurrency"))
+		return
+	}
+	defer t.release()
+
+	schedulerLogger.Info("start to run schedule", "name", schedule.Name, "suite", schedule.Suite)
+	err = t.runFunc(t.ctx, schedule)
+	t.setStatus(schedule.Name, err)
+}
+
+func (t *testScheduler) acquire(maxConcurrency int) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.running >= maxConcurrency {
+		return false
+	}
+	t.running++
+	return true
+}
+
+func (t *testScheduler) release() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.running--
+}
+
+func (t *testScheduler) getRunning() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.running
+}
+
+func (t *testScheduler) setStatus(name string, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	status := &scheduleStatus{
+		lastRunTime: time.Now(),
+	}
+	if err != nil {
+		status.lastError = err.Error()
+	}
+	t.statuses[name] = status
+}
+
+func (t *testScheduler) toGRPCSchedule(schedule testing.Schedule) (result *Schedule) {
+	result = &Schedule{
+		Name:       schedule.Name,
+		Suite:      schedule.Suite,
+		Store:      schedule.Store,
+		Cron:       schedule.Cron,
+		Parameters: mapToPair(schedule.Parameters),
+		Disabled:   schedule.Disabled,
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if status, ok := t.statuses[schedule.Name]; ok {
+		result.LastRunTime = timestamppb.New(status.lastRunTime)
+		result.LastError = status.lastError
+	}
+	if id, ok := t.entries[schedule.Name]; ok {
+		if next := t.cron.Entry(id).Next; !next.IsZero() {
+			result.NextRunTime = timestamppb.New(next)
+		}
+	}
+	return
+}
+
+func getMaxConcurrency(config *testing.ScheduleConfig) int {
+	if config.MaxConcurrency <= 0 {
+		return 1
+	}
+	return config.MaxConcurrency
+}
+
+// ToNormalSchedule converts the gRPC schedule to the normal one
+func ToNormalSchedule(schedule *Schedule) testing.Schedule {
+	return testing.Schedule{
+		Name:       schedule.Name,
+		Suite:      schedule.Suite,
+		Store:      schedule.Store,
+		Cron:       schedule.Cron,
+		Parameters: pairToMap(schedule.Parameters),
+		Disabled:   schedule.Disabled,
+	}
+}
+
+func validateSchedule(schedule testing.Schedule) (err error) {
+	switch {
+	case schedule.Name == "":
+		err = errors.New("name is required")
+	case schedule.Suite == "":
+		err = errors.New("suite is required")
+	default:
+		if _, err = cron.ParseStandard(schedule.Cron); err != nil {
+			err = fmt.Errorf("invalid cron %q: %v", schedule.Cron, err)
+		}
+	}
+	return
+}
+
+// StartScheduler starts to run the scheduled test suites
+func (s *server) StartScheduler(ctx context.Context) error {
+	return s.scheduler.Start(ctx)
+}
+
+// runSchedule runs the test suite of a schedule, the results will be stored as the history
+func (s *server) runSchedule(ctx context.Context, schedule testing.Schedule) (err error) {
+	if schedule.Store != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(HeaderKeyStoreName, schedule.Store))
+	}
+
+	loader := s.getLoader(ctx)
+	defer loader.Close()
+
+	var suite testing.TestSuite
+	if suite, err = loader.GetTestSuite(schedule.Suite, true); err != nil {
+		return
+	}
+
+	var data []byte
+	if data, err = yaml.Marshal(suite); err != nil {
+		return
+	}
+
+	var reply *TestResult
+	if reply, err = s.Run(ctx, &TestTask{
+		Kind:       "suite",
+		Data:       string(data),
+		Level:      "info",
+		Parameters: mapToPair(schedule.Parameters),
+	}); err == nil && reply.Error != "" {
+		err = errors.New(reply.Error)
+	}
+	return
+}
+
+func (s *server) GetSchedules(ctx context.Context, in *Empty) (reply *Schedules, err error) {
+	var schedules []testing.Schedule
+	if schedules, err = s.scheduler.factory.GetSchedules(); err == nil {
+		reply = &Schedules{}
+		for _, schedule := range schedules {
+			reply.Data = append(reply.Data, s.scheduler.toGRPCSchedule(schedule))
+		}
+	}
+	return
+}
+
+func (s *server) CreateSchedule(ctx context.Context, in *Schedule) (reply *Schedule, err error) {
+	schedule := ToNormalSchedule(in)
+	if err = validateSchedule(schedule); err != nil {
+		return
+	}
+
+	if err = s.scheduler.factory.CreateSchedule(schedule); err == nil {
+		if err = s.scheduler.reload(); err == nil {
+			reply = s.scheduler.toGRPCSchedule(schedule)
+		}
+	}
+	return
+}
+
+func (s *server) UpdateSchedule(ctx context.Context, in *Schedule) (reply *Schedule, err error) {
+	schedule := ToNormalSchedule(in)
+	if err = validateSchedule(schedule); err != nil {
+		return
+	}
+
+	if err = s.scheduler.factory.UpdateSchedule(schedule); err == nil {
+		if err = s.scheduler.reload(); err == nil {
+			reply = s.scheduler.toGRPCSchedule(schedule)
+		}
+	}
+	err = toScheduleStatusError(err)
+	return
+}
+
+func (s *server) DeleteSchedule(ctx context.Context, in *Schedule) (reply *Schedule, err error) {
+	reply = &Schedule{}
+	if err = s.scheduler.factory.DeleteSchedule(in.Name); err == nil {
+		err = s.scheduler.reload()
+	}
+	err = toScheduleStatusError(err)
+	return
+}
+
+// toScheduleStatusError converts the not found error of a schedule to the gRPC status
+func toScheduleStatusError(err error) error {
+	if errors.Is(err, testing.ErrScheduleNotFound) {
+		err = status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func (s *server) GetSchedulerStatus(ctx context.Context, in *Empty) (reply *SchedulerStatus, err error) {
+	var config *testing.ScheduleConfig
+	if config, err = s.scheduler.factory.GetScheduleConfig(); err == nil {
+		reply = &SchedulerStatus{
+			Paused:         config.Paused,
+			MaxConcurrency: int32(getMaxConcurrency(config)),
+			Running:        int32(s.scheduler.getRunning()),
+		}
+	}
+	return
+}
+
+func (s *server) UpdateSchedulerStatus(ctx context.Context, in *SchedulerStatus) (reply *SchedulerStatus, err error) {
+	if err = s.scheduler.factory.UpdateScheduleConfig(in.Paused, int(in.MaxConcurrency)); err == nil {
+		reply, err = s.GetSchedulerStatus(ctx, &Empty{})
+	}
+	return
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/h2non/gock"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+func TestScheduleRPCs(t *testing.T) {
+	ctx := context.Background()
+	loader := atest.NewFileWriter("")
+	loader.Put("testdata/simple.yaml")
+	remoteServer := NewRemoteServer(loader, nil, nil, nil, t.TempDir(), 1024*1024*4)
+
+	_, err := remoteServer.CreateSchedule(ctx, &Schedule{Name: "invalid", Suite: "simple", Cron: "invalid"})
+	assert.Error(t, err)
+	_, err = remoteServer.CreateSchedule(ctx, &Schedule{Suite: "simple", Cron: "@every 1h"})
+	assert.Error(t, err)
+	_, err = remoteServer.CreateSchedule(ctx, &Schedule{Name: "monitor", Cron: "@every 1h"})
+	assert.Error(t, err)
+
+	var schedule *Schedule
+	schedule, err = remoteServer.CreateSchedule(ctx, &Schedule{
+		Name:  "monitor",
+		Suite: "simple",
+		Cron:  "*/10 * * * *",
+		Parameters: []*Pair{{
+			Key: "env", Value: "staging",
+		}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "monitor", schedule.Name)
+
+	var schedules *Schedules
+	schedules, err = remoteServer.GetSchedules(ctx, &Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(schedules.Data))
+	assert.Equal(t, "staging", schedules.Data[0].Parameters[0].Value)
+
+	schedule, err = remoteServer.UpdateSchedule(ctx, &Schedule{
+		Name: "monitor", Suite: "simple", Cron: "@every 1h", Disabled: true,
+	})
+	assert.NoError(t, err)
+	assert.True(t, schedule.Disabled)
+	assert.Nil(t, schedule.NextRunTime)
+
+	var status *SchedulerStatus
+	status, err = remoteServer.GetSchedulerStatus(ctx, &Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, &SchedulerStatus{MaxConcurrency: 1}, status)
+
+	status, err = remoteServer.UpdateSchedulerStatus(ctx, &SchedulerStatus{Paused: true, MaxConcurrency: 3})
+	assert.NoError(t, err)
+	assert.True(t, status.Paused)
+	assert.Equal(t, int32(3), status.MaxConcurrency)
+
+	_, err = remoteServer.DeleteSchedule(ctx, &Schedule{Name: "monitor"})
+	assert.NoError(t, err)
+	schedules, err = remoteServer.GetSchedules(ctx, &Empty{})
+	assert.NoError(t, err)
+	assert.Empty(t, schedules.Data)
+
+	_, err = remoteServer.DeleteSchedule(ctx, &Schedule{Name: "monitor"})
+	assert.Equal(t, codes.NotFound, grpcstatus.Code(err))
+	_, err = remoteServer.UpdateSchedule(ctx, &Schedule{Name: "monitor", Suite: "simple", Cron: "@every 1h"})
+	assert.Equal(t, codes.NotFound, grpcstatus.Code(err))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	assert.NoError(t, remoteServer.(SchedulerStarter).StartScheduler(ctx))
+}
+
+func TestRunSchedule(t *testing.T) {
+	defer gock.Off()
+	loader := atest.NewFileWriter("")
+	loader.Put("testdata/simple.yaml")
+	remoteServer := NewRemoteServer(loader, nil, nil, nil, t.TempDir(), 1024*1024*4).(*server)
+
+	t.Run("normal", func(t *testing.T) {
+		gock.New(urlFoo).Get("/").Times(2).Reply(http.StatusOK).JSON(`{}`)
+		err := remoteServer.runSchedule(context.Background(), atest.Schedule{Name: "monitor", Suite: "simple"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		gock.New(urlFoo).Get("/").Reply(http.StatusInternalServerError)
+		err := remoteServer.runSchedule(context.Background(), atest.Schedule{Name: "monitor", Suite: "simple"})
+		assert.Error(t, err)
+	})
+
+	t.Run("the failed result is stored as history", func(t *testing.T) {
+		recorder := &historyRecorder{Writer: loader, results: make(chan atest.TestCaseResult, 2)}
+		historyServer := NewRemoteServer(recorder, nil, nil, nil, t.TempDir(), 1024*1024*4).(*server)
+
+		gock.New(urlFoo).Get("/").Reply(http.StatusInternalServerError)
+		err := historyServer.runSchedule(context.Background(), atest.Schedule{Name: "monitor", Suite: "simple"})
+		assert.Error(t, err)
+
+		select {
+		case result := <-recorder.results:
+			assert.Equal(t, http.StatusInternalServerError, result.StatusCode)
+			assert.NotEmpty(t, result.Error)
+			assert.NotEmpty(t, result.HistoryID)
+		case <-time.After(time.Second):
+			t.Fatal("no history was created")
+		}
+	})
+
+	t.Run("not found suite", func(t *testing.T) {
+		err := remoteServer.runSchedule(context.Background(), atest.Schedule{Name: "monitor", Suite: "fake"})
+		assert.Error(t, err)
+	})
+}
+
+// historyRecorder keeps the created history records
+type historyRecorder struct {
+	atest.Writer
+	results chan atest.TestCaseResult
+}
+
+func (r *historyRecorder) CreateHistoryTestCase(result atest.TestCaseResult, suite *atest.TestSuite, historyHeader map[string]string) error {
+	r.results <- result
+	return nil
+}
+
+func TestTestScheduler(t *testing.T) {
+	factory := atest.NewScheduleFactory(t.TempDir())
+	schedule := atest.Schedule{Name: "monitor", Suite: "simple", Cron: "@every 1h"}
+	assert.NoError(t, factory.CreateSchedule(schedule))
+
+	var count int
+	scheduler := newTestScheduler(factory, func(ctx context.Context, s atest.Schedule) error {
+		count++
+		return errors.New("fake")
+	})
+	assert.NoError(t, scheduler.reload())
+
+	scheduler.run(schedule)
+	assert.Equal(t, 1, count)
+	result := scheduler.toGRPCSchedule(schedule)
+	assert.Equal(t, "fake", result.LastError)
+	assert.NotNil(t, result.LastRunTime)
+
+	t.Run("reach the max concurrency", func(t *testing.T) {
+		scheduler.running = 1
+		defer func() {
+			scheduler.running = 0
+		}()
+		scheduler.run(schedule)
+		assert.Equal(t, 1, count)
+		assert.Contains(t, scheduler.toGRPCSchedule(schedule).LastError, "max concurrency")
+	})
+
+	t.Run("paused", func(t *testing.T) {
+		assert.NoError(t, factory.UpdateScheduleConfig(true, 1))
+		scheduler.run(schedule)
+		assert.Equal(t, 1, count)
+	})
+}
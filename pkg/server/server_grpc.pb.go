@@ -81,6 +81,18 @@ type RunnerClient interface {
 	CreateSecret(ctx context.Context, in *Secret, opts ...grpc.CallOption) (*CommonResult, error)
 	DeleteSecret(ctx context.Context, in *Secret, opts ...grpc.CallOption) (*CommonResult, error)
 	UpdateSecret(ctx context.Context, in *Secret, opts ...grpc.CallOption) (*CommonResult, error)
+	// schedule related interfaces
+	GetSchedules(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Schedules, error)
+	CreateSchedule(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error)
+	UpdateSchedule(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error)
+	DeleteSchedule(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error)
+	GetSchedulerStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SchedulerStatus, error)
+	UpdateSchedulerStatus(ctx context.Context, in *SchedulerStatus, opts ...grpc.CallOption) (*SchedulerStatus, error)
+	// environment related interfaces
+	GetEnvironments(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Environments, error)
+	CreateEnvironment(ctx context.Context, in *Environment, opts ...grpc.CallOption) (*Environment, error)
+	UpdateEnvironment(ctx context.Context, in *Environment, opts ...grpc.CallOption) (*Environment, error)
+	DeleteEnvironment(ctx context.Context, in *Environment, opts ...grpc.CallOption) (*Environment, error)
 	// extension
 	PProf(ctx context.Context, in *PProfRequest, opts ...grpc.CallOption) (*PProfData, error)
 	// AI plugin methods
@@ -603,6 +615,96 @@ func (c *runnerClient) UpdateSecret(ctx context.Context, in *Secret, opts ...grp
 	return out, nil
 }
 
+func (c *runnerClient) GetSchedules(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Schedules, error) {
+	out := new(Schedules)
+	err := c.cc.Invoke(ctx, "/server.Runner/GetSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) CreateSchedule(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error) {
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, "/server.Runner/CreateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) UpdateSchedule(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error) {
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, "/server.Runner/UpdateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) DeleteSchedule(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error) {
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, "/server.Runner/DeleteSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetSchedulerStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SchedulerStatus, error) {
+	out := new(SchedulerStatus)
+	err := c.cc.Invoke(ctx, "/server.Runner/GetSchedulerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) UpdateSchedulerStatus(ctx context.Context, in *SchedulerStatus, opts ...grpc.CallOption) (*SchedulerStatus, error) {
+	out := new(SchedulerStatus)
+	err := c.cc.Invoke(ctx, "/server.Runner/UpdateSchedulerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetEnvironments(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Environments, error) {
+	out := new(Environments)
+	err := c.cc.Invoke(ctx, "/server.Runner/GetEnvironments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) CreateEnvironment(ctx context.Context, in *Environment, opts ...grpc.CallOption) (*Environment, error) {
+	out := new(Environment)
+	err := c.cc.Invoke(ctx, "/server.Runner/CreateEnvironment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) UpdateEnvironment(ctx context.Context, in *Environment, opts ...grpc.CallOption) (*Environment, error) {
+	out := new(Environment)
+	err := c.cc.Invoke(ctx, "/server.Runner/UpdateEnvironment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) DeleteEnvironment(ctx context.Context, in *Environment, opts ...grpc.CallOption) (*Environment, error) {
+	out := new(Environment)
+	err := c.cc.Invoke(ctx, "/server.Runner/DeleteEnvironment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runnerClient) PProf(ctx context.Context, in *PProfRequest, opts ...grpc.CallOption) (*PProfData, error) {
 	out := new(PProfData)
 	err := c.cc.Invoke(ctx, "/server.Runner/PProf", in, out, opts...)
@@ -693,6 +795,18 @@ type RunnerServer interface {
 	CreateSecret(context.Context, *Secret) (*CommonResult, error)
 	DeleteSecret(context.Context, *Secret) (*CommonResult, error)
 	UpdateSecret(context.Context, *Secret) (*CommonResult, error)
+	// schedule related interfaces
+	GetSchedules(context.Context, *Empty) (*Schedules, error)
+	CreateSchedule(context.Context, *Schedule) (*Schedule, error)
+	UpdateSchedule(context.Context, *Schedule) (*Schedule, error)
+	DeleteSchedule(context.Context, *Schedule) (*Schedule, error)
+	GetSchedulerStatus(context.Context, *Empty) (*SchedulerStatus, error)
+	UpdateSchedulerStatus(context.Context, *SchedulerStatus) (*SchedulerStatus, error)
+	// environment related interfaces
+	GetEnvironments(context.Context, *Empty) (*Environments, error)
+	CreateEnvironment(context.Context, *Environment) (*Environment, error)
+	UpdateEnvironment(context.Context, *Environment) (*Environment, error)
+	DeleteEnvironment(context.Context, *Environment) (*Environment, error)
 	// extension
 	PProf(context.Context, *PProfRequest) (*PProfData, error)
 	// AI plugin methods
@@ -852,6 +966,36 @@ func (UnimplementedRunnerServer) DeleteSecret(context.Context, *Secret) (*Common
 func (UnimplementedRunnerServer) UpdateSecret(context.Context, *Secret) (*CommonResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSecret not implemented")
 }
+func (UnimplementedRunnerServer) GetSchedules(context.Context, *Empty) (*Schedules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedules not implemented")
+}
+func (UnimplementedRunnerServer) CreateSchedule(context.Context, *Schedule) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedRunnerServer) UpdateSchedule(context.Context, *Schedule) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedule not implemented")
+}
+func (UnimplementedRunnerServer) DeleteSchedule(context.Context, *Schedule) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (UnimplementedRunnerServer) GetSchedulerStatus(context.Context, *Empty) (*SchedulerStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulerStatus not implemented")
+}
+func (UnimplementedRunnerServer) UpdateSchedulerStatus(context.Context, *SchedulerStatus) (*SchedulerStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedulerStatus not implemented")
+}
+func (UnimplementedRunnerServer) GetEnvironments(context.Context, *Empty) (*Environments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnvironments not implemented")
+}
+func (UnimplementedRunnerServer) CreateEnvironment(context.Context, *Environment) (*Environment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEnvironment not implemented")
+}
+func (UnimplementedRunnerServer) UpdateEnvironment(context.Context, *Environment) (*Environment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnvironment not implemented")
+}
+func (UnimplementedRunnerServer) DeleteEnvironment(context.Context, *Environment) (*Environment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEnvironment not implemented")
+}
 func (UnimplementedRunnerServer) PProf(context.Context, *PProfRequest) (*PProfData, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PProf not implemented")
 }
@@ -1780,6 +1924,186 @@ func _Runner_UpdateSecret_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Runner_GetSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/GetSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetSchedules(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Schedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/CreateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).CreateSchedule(ctx, req.(*Schedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_UpdateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Schedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).UpdateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/UpdateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).UpdateSchedule(ctx, req.(*Schedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Schedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/DeleteSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).DeleteSchedule(ctx, req.(*Schedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetSchedulerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetSchedulerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/GetSchedulerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetSchedulerStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_UpdateSchedulerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulerStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).UpdateSchedulerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/UpdateSchedulerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).UpdateSchedulerStatus(ctx, req.(*SchedulerStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetEnvironments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetEnvironments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/GetEnvironments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetEnvironments(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_CreateEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Environment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).CreateEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/CreateEnvironment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).CreateEnvironment(ctx, req.(*Environment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_UpdateEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Environment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).UpdateEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/UpdateEnvironment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).UpdateEnvironment(ctx, req.(*Environment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_DeleteEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Environment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).DeleteEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/DeleteEnvironment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).DeleteEnvironment(ctx, req.(*Environment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Runner_PProf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PProfRequest)
 	if err := dec(in); err != nil {
@@ -2025,6 +2349,46 @@ var Runner_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSecret",
 			Handler:    _Runner_UpdateSecret_Handler,
 		},
+		{
+			MethodName: "GetSchedules",
+			Handler:    _Runner_GetSchedules_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _Runner_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateSchedule",
+			Handler:    _Runner_UpdateSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _Runner_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "GetSchedulerStatus",
+			Handler:    _Runner_GetSchedulerStatus_Handler,
+		},
+		{
+			MethodName: "UpdateSchedulerStatus",
+			Handler:    _Runner_UpdateSchedulerStatus_Handler,
+		},
+		{
+			MethodName: "GetEnvironments",
+			Handler:    _Runner_GetEnvironments_Handler,
+		},
+		{
+			MethodName: "CreateEnvironment",
+			Handler:    _Runner_CreateEnvironment_Handler,
+		},
+		{
+			MethodName: "UpdateEnvironment",
+			Handler:    _Runner_UpdateEnvironment_Handler,
+		},
+		{
+			MethodName: "DeleteEnvironment",
+			Handler:    _Runner_DeleteEnvironment_Handler,
+		},
 		{
 			MethodName: "PProf",
 			Handler:    _Runner_PProf_Handler,
@@ -2578,6 +2942,9 @@ type MockClient interface {
 	Reload(ctx context.Context, in *MockConfig, opts ...grpc.CallOption) (*Empty, error)
 	GetConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MockConfig, error)
 	LogWatch(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Mock_LogWatchClient, error)
+	GetJournal(ctx context.Context, in *MockJournalFilter, opts ...grpc.CallOption) (*MockJournal, error)
+	ResetJournal(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	VerifyJournal(ctx context.Context, in *MockJournalFilter, opts ...grpc.CallOption) (*MockJournalVerification, error)
 }
 
 type mockClient struct {
@@ -2638,6 +3005,33 @@ func (x *mockLogWatchClient) Recv() (*CommonResult, error) {
 	return m, nil
 }
 
+func (c *mockClient) GetJournal(ctx context.Context, in *MockJournalFilter, opts ...grpc.CallOption) (*MockJournal, error) {
+	out := new(MockJournal)
+	err := c.cc.Invoke(ctx, "/server.Mock/GetJournal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockClient) ResetJournal(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/server.Mock/ResetJournal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockClient) VerifyJournal(ctx context.Context, in *MockJournalFilter, opts ...grpc.CallOption) (*MockJournalVerification, error) {
+	out := new(MockJournalVerification)
+	err := c.cc.Invoke(ctx, "/server.Mock/VerifyJournal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MockServer is the server API for Mock service.
 // All implementations must embed UnimplementedMockServer
 // for forward compatibility
@@ -2645,6 +3039,9 @@ type MockServer interface {
 	Reload(context.Context, *MockConfig) (*Empty, error)
 	GetConfig(context.Context, *Empty) (*MockConfig, error)
 	LogWatch(*Empty, Mock_LogWatchServer) error
+	GetJournal(context.Context, *MockJournalFilter) (*MockJournal, error)
+	ResetJournal(context.Context, *Empty) (*Empty, error)
+	VerifyJournal(context.Context, *MockJournalFilter) (*MockJournalVerification, error)
 	mustEmbedUnimplementedMockServer()
 }
 
@@ -2661,6 +3058,15 @@ func (UnimplementedMockServer) GetConfig(context.Context, *Empty) (*MockConfig,
 func (UnimplementedMockServer) LogWatch(*Empty, Mock_LogWatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method LogWatch not implemented")
 }
+func (UnimplementedMockServer) GetJournal(context.Context, *MockJournalFilter) (*MockJournal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJournal not implemented")
+}
+func (UnimplementedMockServer) ResetJournal(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetJournal not implemented")
+}
+func (UnimplementedMockServer) VerifyJournal(context.Context, *MockJournalFilter) (*MockJournalVerification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyJournal not implemented")
+}
 func (UnimplementedMockServer) mustEmbedUnimplementedMockServer() {}
 
 // UnsafeMockServer may be embedded to opt out of forward compatibility for this service.
@@ -2731,6 +3137,60 @@ func (x *mockLogWatchServer) Send(m *CommonResult) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Mock_GetJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MockJournalFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockServer).GetJournal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Mock/GetJournal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockServer).GetJournal(ctx, req.(*MockJournalFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mock_ResetJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockServer).ResetJournal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Mock/ResetJournal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockServer).ResetJournal(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mock_VerifyJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MockJournalFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockServer).VerifyJournal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Mock/VerifyJournal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockServer).VerifyJournal(ctx, req.(*MockJournalFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Mock_ServiceDesc is the grpc.ServiceDesc for Mock service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2746,6 +3206,18 @@ var Mock_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfig",
 			Handler:    _Mock_GetConfig_Handler,
 		},
+		{
+			MethodName: "GetJournal",
+			Handler:    _Mock_GetJournal_Handler,
+		},
+		{
+			MethodName: "ResetJournal",
+			Handler:    _Mock_ResetJournal_Handler,
+		},
+		{
+			MethodName: "VerifyJournal",
+			Handler:    _Mock_VerifyJournal_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
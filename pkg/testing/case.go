@@ -77,6 +77,29 @@ type RPCDesc struct {
 	ProtoFile        string   `yaml:"protofile,omitempty" json:"protofile,omitempty"`
 	ProtoSet         string   `yaml:"protoset,omitempty" json:"protoset,omitempty"`
 	Raw              string   `yaml:"raw,omitempty" json:"raw,omitempty"`
+	// Protocol is the wire protocol: grpc, grpc-web or connect, default is grpc
+	Protocol string `yaml:"protocol,omitempty" json:"protocol,omitempty"`
+	// Credentials are attached to every call
+	Credentials []RPCCredential `yaml:"credentials,omitempty" json:"credentials,omitempty"`
+}
+
+// RPCCredential is a per-RPC credential, the type is one of bearer, metadata, oauth2 and jwt
+type RPCCredential struct {
+	Type string `yaml:"type" json:"type"`
+	// Token is the static token of bearer
+	Token string `yaml:"token,omitempty" json:"token,omitempty"`
+	// Metadata is the static metadata
+	Metadata map[string]string `yaml:"metadata,omitempty" json:"metadata,omitempty"`
+	// TokenURL, ClientID, ClientSecret and Scopes are used by the oauth2 client credentials flow
+	TokenURL     string   `yaml:"tokenURL,omitempty" json:"tokenURL,omitempty"`
+	ClientID     string   `yaml:"clientID,omitempty" json:"clientID,omitempty"`
+	ClientSecret string   `yaml:"clientSecret,omitempty" json:"clientSecret,omitempty"`
+	Scopes       []string `yaml:"scopes,omitempty" json:"scopes,omitempty"`
+	// Secret (HS256) or KeyFile (RS256) signs the jwt with the claims, it is signed again once expired
+	Secret  string            `yaml:"secret,omitempty" json:"secret,omitempty"`
+	KeyFile string            `yaml:"key,omitempty" json:"key,omitempty"`
+	Claims  map[string]string `yaml:"claims,omitempty" json:"claims,omitempty"`
+	TTL     string            `yaml:"ttl,omitempty" json:"ttl,omitempty"`
 }
 
 type Secure struct {
@@ -85,6 +108,8 @@ type Secure struct {
 	CAFile     string `yaml:"ca,omitempty" json:"ca,omitempty"`
 	KeyFile    string `yaml:"key,omitempty" json:"key,omitempty"`
 	ServerName string `yaml:"serverName,omitempty" json:"serverName,omitempty"`
+	// MinVersion is the minimum TLS version, such as 1.2 or 1.3
+	MinVersion string `yaml:"minVersion,omitempty" json:"minVersion,omitempty"`
 }
 
 type Metric struct {
@@ -108,6 +133,67 @@ type TestCase struct {
 	After   *Job     `yaml:"after,omitempty" json:"after,omitempty"`
 	Request Request  `yaml:"request" json:"request"`
 	Expect  Response `yaml:"expect,omitempty" json:"expect,omitempty"`
+	// Capture extracts the variables from the response, they could be used in the following test cases
+	Capture []Capture `yaml:"capture,omitempty" json:"capture,omitempty"`
+	// Stream is the scripted steps of a gRPC streaming call, the request body is ignored if it is not empty
+	Stream []StreamStep `yaml:"stream,omitempty" json:"stream,omitempty"`
+}
+
+// StreamStep is one of send, expect, wait and closeSend
+type StreamStep struct {
+	// Send is the JSON message to send
+	Send string `yaml:"send,omitempty" json:"send,omitempty"`
+	// Expect is the JSON message which the next received message should match
+	Expect string `yaml:"expect,omitempty" json:"expect,omitempty"`
+	// Wait pauses the stream for a duration, such as: 1s
+	Wait string `yaml:"wait,omitempty" json:"wait,omitempty"`
+	// CloseSend closes the sending direction of the stream
+	CloseSend bool `yaml:"closeSend,omitempty" json:"closeSend,omitempty"`
+	// Timeout is the max duration of the step, it is 10s by default
+	Timeout string `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+// Kind returns the kind of the stream step
+func (s StreamStep) Kind() string {
+	switch {
+	case s.Send != "":
+		return "send"
+	case s.Expect != "":
+		return "expect"
+	case s.Wait != "":
+		return "wait"
+	case s.CloseSend:
+		return "closeSend"
+	}
+	return ""
+}
+
+// the supported types of capture
+const (
+	CaptureTypeJSON   = "json"
+	CaptureTypeRegex  = "regex"
+	CaptureTypeHeader = "header"
+	CaptureTypeCookie = "cookie"
+	CaptureTypeStatus = "status"
+)
+
+// Capture represents a variable which extracts from the response
+type Capture struct {
+	Name string `yaml:"name" json:"name"`
+	// Type could be: json, regex, header, cookie, status
+	Type string `yaml:"type" json:"type"`
+	// Expression is the gjson path, regular expression, header name or cookie name
+	Expression string `yaml:"expression,omitempty" json:"expression,omitempty"`
+}
+
+// Captures returns true if the test case captures the given variable
+func (c *TestCase) Captures(name string) bool {
+	for _, item := range c.Capture {
+		if item.Name == name {
+			return true
+		}
+	}
+	return false
 }
 
 // InScope returns true if the test case is in scope with the given items.
@@ -150,20 +236,32 @@ func NewRequestBody(val string) RequestBody {
 	return RequestBody{Value: val}
 }
 
+// UnmarshalYAML accepts a string, or a map which is taken as a JSON object,
+// such as the GraphQL request and the structured SOAP body
 func (e *RequestBody) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
-	gql := &GraphQLRequestBody{}
-	err = unmarshal(gql)
-	if err != nil {
+	data := map[string]interface{}{}
+	if err = unmarshal(&data); err != nil {
 		val := ""
 		if err = unmarshal(&val); err == nil {
 			e.Value = val
 		}
-	} else {
-		var data []byte
-		if data, err = json.Marshal(gql); err == nil {
-			e.Value = string(data)
-			e.isJson = true
+		return
+	}
+
+	var body interface{} = data
+	if _, ok := data["query"]; ok {
+		// keep the fields order of the GraphQL request
+		gql := &GraphQLRequestBody{}
+		if err = unmarshal(gql); err != nil {
+			return
 		}
+		body = gql
+	}
+
+	var raw []byte
+	if raw, err = json.Marshal(body); err == nil {
+		e.Value = string(raw)
+		e.isJson = true
 	}
 	return
 }
@@ -171,9 +269,17 @@ func (e *RequestBody) UnmarshalYAML(unmarshal func(interface{}) error) (err erro
 func (e RequestBody) MarshalYAML() (val interface{}, err error) {
 	val = e.Value
 	if e.isJson {
-		gql := &GraphQLRequestBody{}
-		if err = json.Unmarshal([]byte(e.Value), gql); err == nil {
-			val = gql
+		data := map[string]interface{}{}
+		if err = json.Unmarshal([]byte(e.Value), &data); err != nil {
+			return
+		}
+		val = data
+
+		if _, ok := data["query"]; ok {
+			gql := &GraphQLRequestBody{}
+			if err = json.Unmarshal([]byte(e.Value), gql); err == nil {
+				val = gql
+			}
 		}
 	}
 	return
@@ -217,9 +323,9 @@ func decodeBase64Body(raw, prefix string) ([]byte, error) {
 }
 
 type GraphQLRequestBody struct {
-	Query         string            `yaml:"query" json:"query"`
-	OperationName string            `yaml:"operationName" json:"operationName"`
-	Variables     map[string]string `yaml:"variables" json:"variables"`
+	Query         string                 `yaml:"query" json:"query"`
+	OperationName string                 `yaml:"operationName" json:"operationName"`
+	Variables     map[string]interface{} `yaml:"variables" json:"variables"`
 }
 
 // Response is the expected response
@@ -231,6 +337,8 @@ type Response struct {
 	Verify            []string               `yaml:"verify,omitempty" json:"verify,omitempty"`
 	ConditionalVerify []ConditionalVerify    `yaml:"conditionalVerify,omitempty" json:"conditionalVerify,omitempty"`
 	Schema            string                 `yaml:"schema,omitempty" json:"schema,omitempty"`
+	// GRPCCode is the expected gRPC status code, such as: NOT_FOUND, 5. It is OK by default
+	GRPCCode string `yaml:"grpcCode,omitempty" json:"grpcCode,omitempty"`
 }
 
 func (r Response) GetBody() string {
@@ -316,4 +424,6 @@ type TestCaseResult struct {
 	Error      string            `yaml:"error,omitempty" json:"error,omitempty"`
 	Id         string            `yaml:"id,omitempty" json:"id,omitempty"`
 	Output     string            `yaml:"output,omitempty" json:"output,omitempty"`
+	// HistoryID is the ID of the history record which stores this result
+	HistoryID string `yaml:"historyID,omitempty" json:"historyID,omitempty"`
 }
@@ -51,6 +51,20 @@ body:
 	assert.Nil(t, err)
 	assert.Equal(t, graphqlBody, string(data))
 
+	structuredBody := `api: /api
+body:
+    Add:
+        intA: 1
+        intB: 2
+`
+	err = yaml.Unmarshal([]byte(structuredBody), req)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Add":{"intA":1,"intB":2}}`, req.Body.String())
+
+	data, err = yaml.Marshal(req)
+	assert.Nil(t, err)
+	assert.Equal(t, structuredBody, string(data))
+
 	err = yaml.Unmarshal([]byte(`body: plain`), req)
 	assert.Nil(t, err)
 	assert.Equal(t, "plain", req.Body.String())
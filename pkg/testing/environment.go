@@ -0,0 +1,185 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/linuxsuren/api-testing/pkg/secret"
+	"gopkg.in/yaml.v3"
+)
+
+// Environment represents a named set of variables, such as: dev, staging, prod
+type Environment struct {
+	Name      string            `yaml:"name"`
+	Variables map[string]string `yaml:"variables,omitempty"`
+	// Secrets maps the variable name to the secret name
+	Secrets map[string]string `yaml:"secrets,omitempty"`
+}
+
+// Resolve returns all the variables, including the values of the secret references
+func (e *Environment) Resolve(getter secret.SecretGetter) (result map[string]string, err error) {
+	result = make(map[string]string, len(e.Variables)+len(e.Secrets))
+	for key, val := range e.Variables {
+		result[key] = val
+	}
+
+	for key, name := range e.Secrets {
+		if getter == nil {
+			err = fmt.Errorf("no secret getter for the secret %q of environment %s", name, e.Name)
+			return
+		}
+
+		var s secret.Secret
+		if s, err = getter.GetSecret(name); err != nil {
+			err = fmt.Errorf("failed to get secret %q of environment %s: %v", name, e.Name, err)
+			return
+		}
+		result[key] = s.Value
+	}
+	return
+}
+
+// EnvironmentGetterAndSetter is the interface of the environments persistence
+type EnvironmentGetterAndSetter interface {
+	GetEnvironments() (envs []Environment, err error)
+	GetEnvironment(name string) (env *Environment, err error)
+	CreateEnvironment(env Environment) (err error)
+	UpdateEnvironment(env Environment) (err error)
+	DeleteEnvironment(name string) (err error)
+}
+
+// ErrEnvironmentNotFound indicates there is no environment with the given name
+var ErrEnvironmentNotFound = errors.New("environment not found")
+
+type environmentFactory struct {
+	configDir string
+	// mu guards the read-modify-write of the environments file
+	mu sync.Mutex
+}
+
+// NewEnvironmentFactory creates a new environment factory which stores data in the config directory
+func NewEnvironmentFactory(configDir string) EnvironmentGetterAndSetter {
+	return &environmentFactory{
+		configDir: configDir,
+	}
+}
+
+// GetEnvironments returns all environments
+func (e *environmentFactory) GetEnvironments() (envs []Environment, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.load()
+}
+
+// load reads the environments file, it is empty if the file does not exist
+func (e *environmentFactory) load() (envs []Environment, err error) {
+	var data []byte
+	if data, err = os.ReadFile(path.Join(e.configDir, "environments.yaml")); err == nil {
+		err = yaml.Unmarshal(data, &envs)
+	} else if errors.Is(err, os.ErrNotExist) {
+		err = nil
+	}
+	return
+}
+
+// GetEnvironment returns the environment with the given name
+func (e *environmentFactory) GetEnvironment(name string) (env *Environment, err error) {
+	var envs []Environment
+	if envs, err = e.GetEnvironments(); err == nil {
+		for i := range envs {
+			if envs[i].Name == name {
+				env = &envs[i]
+				return
+			}
+		}
+		err = fmt.Errorf("%w: %s", ErrEnvironmentNotFound, name)
+	}
+	return
+}
+
+// CreateEnvironment creates a new environment
+func (e *environmentFactory) CreateEnvironment(env Environment) (err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var envs []Environment
+	if envs, err = e.load(); err == nil {
+		for _, item := range envs {
+			if item.Name == env.Name {
+				err = fmt.Errorf("environment %s already exists", env.Name)
+				return
+			}
+		}
+
+		envs = append(envs, env)
+		err = e.save(envs)
+	}
+	return
+}
+
+// UpdateEnvironment updates an existing environment
+func (e *environmentFactory) UpdateEnvironment(env Environment) (err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var envs []Environment
+	if envs, err = e.load(); err == nil {
+		for i := range envs {
+			if envs[i].Name == env.Name {
+				envs[i] = env
+				err = e.save(envs)
+				return
+			}
+		}
+		err = fmt.Errorf("%w: %s", ErrEnvironmentNotFound, env.Name)
+	}
+	return
+}
+
+// DeleteEnvironment deletes an environment by name
+func (e *environmentFactory) DeleteEnvironment(name string) (err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var envs []Environment
+	if envs, err = e.load(); err == nil {
+		for i := range envs {
+			if envs[i].Name == name {
+				envs = append(envs[:i], envs[i+1:]...)
+				err = e.save(envs)
+				return
+			}
+		}
+		err = fmt.Errorf("%w: %s", ErrEnvironmentNotFound, name)
+	}
+	return
+}
+
+func (e *environmentFactory) save(envs []Environment) (err error) {
+	if err = os.MkdirAll(e.configDir, 0755); err == nil {
+		var data []byte
+		if data, err = yaml.Marshal(envs); err == nil {
+			err = os.WriteFile(path.Join(e.configDir, "environments.yaml"), data, 0644)
+		}
+	}
+	return
+}
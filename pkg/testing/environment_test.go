@@ -0,0 +1,115 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/pkg/secret"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironmentFactory(t *testing.T) {
+	factory := NewEnvironmentFactory(t.TempDir())
+
+	envs, err := factory.GetEnvironments()
+	assert.NoError(t, err)
+	assert.Empty(t, envs)
+
+	env := Environment{
+		Name: "staging",
+		Variables: map[string]string{
+			"SERVER": "http://staging",
+		},
+		Secrets: map[string]string{
+			"TOKEN": "staging-token",
+		},
+	}
+	assert.NoError(t, factory.CreateEnvironment(env))
+	assert.Error(t, factory.CreateEnvironment(env))
+
+	var target *Environment
+	target, err = factory.GetEnvironment("staging")
+	assert.NoError(t, err)
+	assert.Equal(t, &env, target)
+
+	_, err = factory.GetEnvironment("fake")
+	assert.Error(t, err)
+
+	env.Variables["SERVER"] = "http://staging.com"
+	assert.NoError(t, factory.UpdateEnvironment(env))
+	target, err = factory.GetEnvironment("staging")
+	assert.NoError(t, err)
+	assert.Equal(t, "http://staging.com", target.Variables["SERVER"])
+
+	assert.ErrorIs(t, factory.UpdateEnvironment(Environment{Name: "fake"}), ErrEnvironmentNotFound)
+
+	assert.NoError(t, factory.DeleteEnvironment("staging"))
+	envs, err = factory.GetEnvironments()
+	assert.NoError(t, err)
+	assert.Empty(t, envs)
+	assert.ErrorIs(t, factory.DeleteEnvironment("staging"), ErrEnvironmentNotFound)
+
+	t.Run("invalid file", func(t *testing.T) {
+		dir := t.TempDir()
+		assert.NoError(t, os.Mkdir(filepath.Join(dir, "environments.yaml"), 0755))
+		_, err := NewEnvironmentFactory(dir).GetEnvironments()
+		assert.Error(t, err)
+	})
+}
+
+type fakeSecretGetter struct {
+	err error
+}
+
+func (f *fakeSecretGetter) GetSecret(name string) (s secret.Secret, err error) {
+	s = secret.Secret{Name: name, Value: name + "-value"}
+	err = f.err
+	return
+}
+
+func TestEnvironmentResolve(t *testing.T) {
+	env := &Environment{
+		Name: "staging",
+		Variables: map[string]string{
+			"SERVER": "http://staging",
+		},
+		Secrets: map[string]string{
+			"TOKEN": "token",
+		},
+	}
+
+	result, err := env.Resolve(&fakeSecretGetter{})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"SERVER": "http://staging",
+		"TOKEN":  "token-value",
+	}, result)
+
+	_, err = env.Resolve(&fakeSecretGetter{err: errors.New("fake")})
+	assert.Error(t, err)
+
+	_, err = env.Resolve(nil)
+	assert.Error(t, err)
+
+	result, err = (&Environment{Name: "empty"}).Resolve(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+}
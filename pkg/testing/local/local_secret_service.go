@@ -21,6 +21,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/linuxsuren/api-testing/pkg/secret"
 	"github.com/linuxsuren/api-testing/pkg/server"
 	"github.com/linuxsuren/api-testing/pkg/testing/remote"
 	"gopkg.in/yaml.v3"
@@ -31,6 +32,8 @@ type localSecretService struct {
 	remote.UnimplementedSecretServiceServer
 }
 
+// NewLocalSecretService creates a secret service which stores the encrypted secrets in the data directory.
+// The master key comes from the environment variable or the key file of the data directory.
 func NewLocalSecretService(dataDir string) remote.SecretServiceServer {
 	return &localSecretService{
 		dataDir: dataDir,
@@ -51,22 +54,22 @@ func (s *localSecretService) GetSecret(ctx context.Context, in *server.Secret) (
 }
 
 func (s *localSecretService) GetSecrets(ctx context.Context, in *server.Empty) (reply *server.Secrets, err error) {
-	secretFile := s.getDataFilePath()
-	var data []byte
-	if data, err = os.ReadFile(secretFile); err == nil {
-		secretData := make(map[string]string)
+	var c secret.Cipher
+	if c, err = secret.GetCipher(s.dataDir); err != nil {
+		return
+	}
 
-		if err = yaml.Unmarshal(data, &secretData); err == nil {
-			reply = &server.Secrets{
-				Data: make([]*server.Secret, 0),
-			}
+	var secretData map[string]string
+	if secretData, err = s.readSecrets(c); err == nil {
+		reply = &server.Secrets{
+			Data: make([]*server.Secret, 0),
+		}
 
-			for key, value := range secretData {
-				reply.Data = append(reply.Data, &server.Secret{
-					Name:  key,
-					Value: value,
-				})
-			}
+		for key, value := range secretData {
+			reply.Data = append(reply.Data, &server.Secret{
+				Name:  key,
+				Value: value,
+			})
 		}
 	}
 	return
@@ -83,36 +86,16 @@ func (s *localSecretService) CreateSecret(ctx context.Context, in *server.Secret
 		file.Close()
 	}
 
-	var data []byte
-	if data, err = os.ReadFile(secretFile); err == nil {
-		secretData := make(map[string]string)
-
-		if err = yaml.Unmarshal(data, &secretData); err == nil {
-			secretData[in.Name] = in.Value
-
-			if data, err = yaml.Marshal(secretData); err == nil {
-				err = os.WriteFile(secretFile, data, 0644)
-			}
-		}
-	}
+	err = s.updateSecrets(func(secretData map[string]string) {
+		secretData[in.Name] = in.Value
+	})
 	return
 }
 
 func (s *localSecretService) DeleteSecret(ctx context.Context, in *server.Secret) (reply *server.CommonResult, err error) {
-	secretFile := s.getDataFilePath()
-
-	var data []byte
-	if data, err = os.ReadFile(secretFile); err == nil {
-		secretData := make(map[string]string)
-
-		if err = yaml.Unmarshal(data, &secretData); err == nil {
-			delete(secretData, in.Name)
-
-			if data, err = yaml.Marshal(secretData); err == nil {
-				err = os.WriteFile(secretFile, data, 0644)
-			}
-		}
-	}
+	err = s.updateSecrets(func(secretData map[string]string) {
+		delete(secretData, in.Name)
+	})
 	return
 }
 
@@ -121,6 +104,19 @@ func (s *localSecretService) UpdateSecret(ctx context.Context, in *server.Secret
 	return
 }
 
+// RotateSecret re-encrypts all the secrets with the new cipher
+func (s *localSecretService) RotateSecret(oldCipher, newCipher secret.Cipher) (err error) {
+	if _, fErr := os.Stat(s.getDataFilePath()); fErr != nil {
+		return
+	}
+
+	var secretData map[string]string
+	if secretData, err = s.readSecrets(oldCipher); err == nil {
+		err = s.writeSecrets(secretData, newCipher)
+	}
+	return
+}
+
 func (s *localSecretService) Query(query map[string]string) (result map[string]string, err error) {
 	result = make(map[string]string)
 	return
@@ -134,6 +130,53 @@ func (s *localSecretService) GetTheme(string) (result string, err error) {
 	return
 }
 
+func (s *localSecretService) updateSecrets(update func(map[string]string)) (err error) {
+	var c secret.Cipher
+	if c, err = secret.GetCipher(s.dataDir); err != nil {
+		return
+	}
+
+	var secretData map[string]string
+	if secretData, err = s.readSecrets(c); err == nil {
+		update(secretData)
+		err = s.writeSecrets(secretData, c)
+	}
+	return
+}
+
+// readSecrets returns the decrypted secrets
+func (s *localSecretService) readSecrets(c secret.Cipher) (secretData map[string]string, err error) {
+	var data []byte
+	if data, err = os.ReadFile(s.getDataFilePath()); err != nil {
+		return
+	}
+
+	secretData = make(map[string]string)
+	if err = yaml.Unmarshal(data, &secretData); err == nil {
+		for key, value := range secretData {
+			if secretData[key], err = c.Decrypt(value); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+func (s *localSecretService) writeSecrets(secretData map[string]string, c secret.Cipher) (err error) {
+	encrypted := make(map[string]string, len(secretData))
+	for key, value := range secretData {
+		if encrypted[key], err = c.Encrypt(value); err != nil {
+			return
+		}
+	}
+
+	var data []byte
+	if data, err = yaml.Marshal(encrypted); err == nil {
+		err = os.WriteFile(s.getDataFilePath(), data, 0600)
+	}
+	return
+}
+
 func (s *localSecretService) getDataFilePath() string {
 	return filepath.Join(s.dataDir, "secret.yaml")
 }
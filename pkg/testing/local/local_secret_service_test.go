@@ -19,8 +19,10 @@ package local
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/linuxsuren/api-testing/pkg/secret"
 	"github.com/linuxsuren/api-testing/pkg/server"
 	"github.com/stretchr/testify/assert"
 )
@@ -66,3 +68,45 @@ func TestLocalSecretService(t *testing.T) {
 		assert.Len(t, secrets.Data, 0)
 	})
 }
+
+func TestLocalSecretServiceWithMasterKey(t *testing.T) {
+	dataDir := t.TempDir()
+	ctx := context.Background()
+	assert.NoError(t, secret.InitMasterKey(dataDir))
+
+	service := NewLocalSecretService(dataDir)
+	_, err := service.CreateSecret(ctx, &server.Secret{
+		Name:  "token",
+		Value: "plain-value",
+	})
+	assert.NoError(t, err)
+
+	var data []byte
+	data, err = os.ReadFile(filepath.Join(dataDir, "secret.yaml"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "plain-value")
+
+	var item *server.Secret
+	item, err = service.GetSecret(ctx, &server.Secret{Name: "token"})
+	assert.NoError(t, err)
+	assert.Equal(t, "plain-value", item.Value)
+
+	t.Run("wrong master key", func(t *testing.T) {
+		t.Setenv(secret.EnvMasterKey, "wrong")
+		_, err := service.GetSecrets(ctx, &server.Empty{})
+		assert.Error(t, err)
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		oldKey, err := secret.GetMasterKey(dataDir)
+		assert.NoError(t, err)
+
+		rotator := service.(secret.Rotator)
+		assert.NoError(t, rotator.RotateSecret(secret.NewCipher(oldKey), secret.NewCipher("new")))
+		assert.NoError(t, secret.SaveMasterKey(dataDir, "new"))
+
+		item, err := service.GetSecret(ctx, &server.Secret{Name: "token"})
+		assert.NoError(t, err)
+		assert.Equal(t, "plain-value", item.Value)
+	})
+}
@@ -35,6 +35,8 @@ import (
 
 const (
 	ContextKeyGlobalParam = "param"
+	// ContextKeyEnv is the key of the environment variables, such as: {{.env.SERVER}}
+	ContextKeyEnv = "env"
 )
 
 // Parse parses a file and returns the test suite
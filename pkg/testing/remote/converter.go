@@ -61,6 +61,8 @@ func ConvertToNormalTestSuiteSpec(spec *server.APISpec) (result testing.APISpec)
 				ProtoFile:        spec.Rpc.Protofile,
 				ImportPath:       spec.Rpc.Import,
 				ServerReflection: spec.Rpc.ServerReflection,
+				Protocol:         spec.Rpc.Protocol,
+				Credentials:      server.ToNormalCredentials(spec.Rpc.Credentials),
 			}
 		}
 		if spec.Secure != nil {
@@ -70,6 +72,7 @@ func ConvertToNormalTestSuiteSpec(spec *server.APISpec) (result testing.APISpec)
 				CAFile:     spec.Secure.Ca,
 				ServerName: spec.Secure.ServerName,
 				KeyFile:    spec.Secure.Key,
+				MinVersion: spec.Secure.MinVersion,
 			}
 		}
 	}
@@ -93,6 +96,7 @@ func ConvertToGRPCTestSuite(suite *testing.TestSuite) (result *TestSuite) {
 			Ca:         suite.Spec.Secure.CAFile,
 			ServerName: suite.Spec.Secure.ServerName,
 			Key:        suite.Spec.Secure.KeyFile,
+			MinVersion: suite.Spec.Secure.MinVersion,
 		}
 	}
 	if suite.Spec.RPC != nil {
@@ -102,6 +106,8 @@ func ConvertToGRPCTestSuite(suite *testing.TestSuite) (result *TestSuite) {
 			Protofile:        suite.Spec.RPC.ProtoFile,
 			Protoset:         suite.Spec.RPC.ProtoSet,
 			Raw:              suite.Spec.RPC.Raw,
+			Protocol:         suite.Spec.RPC.Protocol,
+			Credentials:      server.ToGRPCCredentials(suite.Spec.RPC.Credentials),
 		}
 	}
 
@@ -130,6 +136,7 @@ func ConvertToNormalTestCase(testcase *server.TestCase) (result testing.TestCase
 			Body:             testcase.Response.Body,
 			StatusCode:       int(testcase.Response.StatusCode),
 			Schema:           testcase.Response.Schema,
+			GRPCCode:         testcase.Response.GrpcCode,
 			Verify:           testcase.Response.Verify,
 			Header:           pairToMap(testcase.Response.Header),
 			BodyFieldsExpect: pairToInterMap(testcase.Response.BodyFieldsExpect),
@@ -165,6 +172,7 @@ func ConvertToNormalHistoryTestCase(testcase *server.HistoryTestCase) (result te
 			Body:             testcase.Response.Body,
 			StatusCode:       int(testcase.Response.StatusCode),
 			Schema:           testcase.Response.Schema,
+			GRPCCode:         testcase.Response.GrpcCode,
 			Verify:           testcase.Response.Verify,
 			Header:           pairToMap(testcase.Response.Header),
 			BodyFieldsExpect: pairToInterMap(testcase.Response.BodyFieldsExpect),
@@ -193,6 +201,7 @@ func ConvertHistoryToGRPCTestCase(historyTestcase *server.HistoryTestCase) (resu
 			Body:             historyTestcase.Response.Body,
 			StatusCode:       int(historyTestcase.Response.StatusCode),
 			Schema:           historyTestcase.Response.Schema,
+			GRPCCode:         historyTestcase.Response.GrpcCode,
 			Verify:           historyTestcase.Response.Verify,
 			Header:           pairToMap(historyTestcase.Response.Header),
 			BodyFieldsExpect: pairToInterMap(historyTestcase.Response.BodyFieldsExpect),
@@ -216,6 +225,7 @@ func ConvertToGRPCTestCase(testcase testing.TestCase) (result *server.TestCase)
 			Body:             testcase.Expect.Body,
 			StatusCode:       int32(testcase.Expect.StatusCode),
 			Schema:           testcase.Expect.Schema,
+			GrpcCode:         testcase.Expect.GRPCCode,
 			Verify:           testcase.Expect.Verify,
 			Header:           mapToPair(testcase.Expect.Header),
 			BodyFieldsExpect: mapInterToPair(testcase.Expect.BodyFieldsExpect),
@@ -228,6 +238,7 @@ func ConvertToGRPCHistoryTestCase(historyTestcase testing.HistoryTestCase) (resu
 	req := historyTestcase.Data.Request
 	res := historyTestcase.Data.Expect
 	result = &server.HistoryTestCase{
+		ID:            historyTestcase.ID,
 		CaseName:      historyTestcase.CaseName,
 		SuiteName:     historyTestcase.SuiteName,
 		SuiteApi:      historyTestcase.SuiteAPI,
@@ -247,6 +258,7 @@ func ConvertToGRPCHistoryTestCase(historyTestcase testing.HistoryTestCase) (resu
 			Body:             res.Body,
 			StatusCode:       int32(res.StatusCode),
 			Schema:           res.Schema,
+			GrpcCode:         res.GRPCCode,
 			Verify:           res.Verify,
 			Header:           mapToPair(res.Header),
 			BodyFieldsExpect: mapInterToPair(res.BodyFieldsExpect),
@@ -320,6 +332,7 @@ func ConvertToGRPCHistoryTestCaseResult(testCaseResult testing.TestCaseResult, t
 
 	for _, testCase := range testSuite.Items {
 		data := testing.HistoryTestCase{
+			ID:            testCaseResult.HistoryID,
 			CaseName:      testCase.Name,
 			SuiteName:     testSuite.Name,
 			SuiteAPI:      testSuite.API,
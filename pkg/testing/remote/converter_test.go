@@ -266,8 +266,9 @@ func TestConvert(t *testing.T) {
 
 	t.Run("convertToGRPCHistoryTestCaseResult", func(t *testing.T) {
 		result := ConvertToGRPCHistoryTestCaseResult(atest.TestCaseResult{
-			Body:   "fake body",
-			Output: "fake output",
+			Body:      "fake body",
+			Output:    "fake output",
+			HistoryID: "fake-id",
 		}, &atest.TestSuite{
 			Param: defaultMap,
 			Spec: atest.APISpec{
@@ -292,6 +293,7 @@ func TestConvert(t *testing.T) {
 		assert.Equal(t, "fake", result.Data.SuiteSpec.Secure.Key)
 		assert.Equal(t, "fake output", result.TestCaseResult[0].Output)
 		assert.Equal(t, "fake body", result.TestCaseResult[0].Body)
+		assert.Equal(t, "fake-id", result.Data.ID)
 	})
 
 	t.Run("convertToNormalTestCaseResult", func(t *testing.T) {
@@ -0,0 +1,197 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ScheduleConfig holds all the scheduled test runs and the scheduler settings
+type ScheduleConfig struct {
+	Paused         bool       `yaml:"paused"`
+	MaxConcurrency int        `yaml:"maxConcurrency"`
+	Schedules      []Schedule `yaml:"schedules"`
+}
+
+// Schedule represents a cron-like test run of a test suite
+type Schedule struct {
+	Name       string            `yaml:"name"`
+	Suite      string            `yaml:"suite"`
+	Store      string            `yaml:"store,omitempty"`
+	Cron       string            `yaml:"cron"`
+	Parameters map[string]string `yaml:"parameters,omitempty"`
+	Disabled   bool              `yaml:"disabled,omitempty"`
+}
+
+// ScheduleGetterAndSetter is the interface of the schedules persistence
+type ScheduleGetterAndSetter interface {
+	GetScheduleConfig() (config *ScheduleConfig, err error)
+	UpdateScheduleConfig(paused bool, maxConcurrency int) (err error)
+
+	GetSchedules() (schedules []Schedule, err error)
+	GetSchedule(name string) (schedule *Schedule, err error)
+	CreateSchedule(schedule Schedule) (err error)
+	UpdateSchedule(schedule Schedule) (err error)
+	DeleteSchedule(name string) (err error)
+}
+
+// ErrScheduleNotFound indicates there is no schedule with the given name
+var ErrScheduleNotFound = errors.New("schedule not found")
+
+type scheduleFactory struct {
+	configDir string
+	// mu guards the read-modify-write of the schedules file
+	mu sync.Mutex
+}
+
+// NewScheduleFactory creates a new schedule factory which stores data in the config directory
+func NewScheduleFactory(configDir string) ScheduleGetterAndSetter {
+	return &scheduleFactory{
+		configDir: configDir,
+	}
+}
+
+// GetScheduleConfig returns the whole schedule config
+func (s *scheduleFactory) GetScheduleConfig() (config *ScheduleConfig, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.load()
+}
+
+// load reads the schedules file, it is empty if the file does not exist
+func (s *scheduleFactory) load() (config *ScheduleConfig, err error) {
+	config = &ScheduleConfig{}
+	var data []byte
+	if data, err = os.ReadFile(path.Join(s.configDir, "schedules.yaml")); err == nil {
+		err = yaml.Unmarshal(data, config)
+	} else if errors.Is(err, os.ErrNotExist) {
+		err = nil
+	}
+	return
+}
+
+// UpdateScheduleConfig updates the scheduler settings
+func (s *scheduleFactory) UpdateScheduleConfig(paused bool, maxConcurrency int) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var config *ScheduleConfig
+	if config, err = s.load(); err == nil {
+		config.Paused = paused
+		config.MaxConcurrency = maxConcurrency
+		err = s.save(config)
+	}
+	return
+}
+
+// GetSchedules returns all schedules
+func (s *scheduleFactory) GetSchedules() (schedules []Schedule, err error) {
+	var config *ScheduleConfig
+	if config, err = s.GetScheduleConfig(); err == nil {
+		schedules = config.Schedules
+	}
+	return
+}
+
+// GetSchedule returns the schedule with the given name
+func (s *scheduleFactory) GetSchedule(name string) (schedule *Schedule, err error) {
+	var schedules []Schedule
+	if schedules, err = s.GetSchedules(); err == nil {
+		for i := range schedules {
+			if schedules[i].Name == name {
+				schedule = &schedules[i]
+				return
+			}
+		}
+		err = fmt.Errorf("%w: %s", ErrScheduleNotFound, name)
+	}
+	return
+}
+
+// CreateSchedule creates a new schedule
+func (s *scheduleFactory) CreateSchedule(schedule Schedule) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var config *ScheduleConfig
+	if config, err = s.load(); err == nil {
+		for _, item := range config.Schedules {
+			if item.Name == schedule.Name {
+				err = fmt.Errorf("schedule %s already exists", schedule.Name)
+				return
+			}
+		}
+
+		config.Schedules = append(config.Schedules, schedule)
+		err = s.save(config)
+	}
+	return
+}
+
+// UpdateSchedule updates an existing schedule
+func (s *scheduleFactory) UpdateSchedule(schedule Schedule) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var config *ScheduleConfig
+	if config, err = s.load(); err == nil {
+		for i := range config.Schedules {
+			if config.Schedules[i].Name == schedule.Name {
+				config.Schedules[i] = schedule
+				err = s.save(config)
+				return
+			}
+		}
+		err = fmt.Errorf("%w: %s", ErrScheduleNotFound, schedule.Name)
+	}
+	return
+}
+
+// DeleteSchedule deletes a schedule by name
+func (s *scheduleFactory) DeleteSchedule(name string) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var config *ScheduleConfig
+	if config, err = s.load(); err == nil {
+		for i := range config.Schedules {
+			if config.Schedules[i].Name == name {
+				config.Schedules = append(config.Schedules[:i], config.Schedules[i+1:]...)
+				err = s.save(config)
+				return
+			}
+		}
+		err = fmt.Errorf("%w: %s", ErrScheduleNotFound, name)
+	}
+	return
+}
+
+func (s *scheduleFactory) save(config *ScheduleConfig) (err error) {
+	if err = os.MkdirAll(s.configDir, 0755); err == nil {
+		var data []byte
+		if data, err = yaml.Marshal(config); err == nil {
+			err = os.WriteFile(path.Join(s.configDir, "schedules.yaml"), data, 0644)
+		}
+	}
+	return
+}
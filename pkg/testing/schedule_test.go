@@ -0,0 +1,99 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduleFactory(t *testing.T) {
+	factory := NewScheduleFactory(t.TempDir())
+
+	schedules, err := factory.GetSchedules()
+	assert.NoError(t, err)
+	assert.Empty(t, schedules)
+
+	schedule := Schedule{
+		Name:  "staging",
+		Suite: "sample",
+		Store: "local",
+		Cron:  "@every 10m",
+		Parameters: map[string]string{
+			"env": "staging",
+		},
+	}
+	assert.NoError(t, factory.CreateSchedule(schedule))
+	assert.Error(t, factory.CreateSchedule(schedule))
+
+	var target *Schedule
+	target, err = factory.GetSchedule("staging")
+	assert.NoError(t, err)
+	assert.Equal(t, &schedule, target)
+
+	_, err = factory.GetSchedule("fake")
+	assert.Error(t, err)
+
+	schedule.Disabled = true
+	assert.NoError(t, factory.UpdateSchedule(schedule))
+	target, err = factory.GetSchedule("staging")
+	assert.NoError(t, err)
+	assert.True(t, target.Disabled)
+	assert.Error(t, factory.UpdateSchedule(Schedule{Name: "fake"}))
+
+	assert.NoError(t, factory.UpdateScheduleConfig(true, 3))
+	var config *ScheduleConfig
+	config, err = factory.GetScheduleConfig()
+	assert.NoError(t, err)
+	assert.True(t, config.Paused)
+	assert.Equal(t, 3, config.MaxConcurrency)
+	assert.Equal(t, 1, len(config.Schedules))
+
+	assert.NoError(t, factory.DeleteSchedule("staging"))
+	schedules, err = factory.GetSchedules()
+	assert.NoError(t, err)
+	assert.Empty(t, schedules)
+	assert.ErrorIs(t, factory.DeleteSchedule("staging"), ErrScheduleNotFound)
+
+	t.Run("concurrent creation", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				assert.NoError(t, factory.CreateSchedule(Schedule{Name: fmt.Sprintf("schedule-%d", i), Cron: "@every 1h"}))
+			}(i)
+		}
+		wg.Wait()
+
+		schedules, err := factory.GetSchedules()
+		assert.NoError(t, err)
+		assert.Equal(t, 10, len(schedules))
+	})
+
+	t.Run("invalid schedules file", func(t *testing.T) {
+		configDir := t.TempDir()
+		assert.NoError(t, os.Mkdir(path.Join(configDir, "schedules.yaml"), 0755))
+		_, err := NewScheduleFactory(configDir).GetScheduleConfig()
+		assert.Error(t, err)
+	})
+}
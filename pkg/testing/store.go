@@ -30,6 +30,7 @@ import (
 	"path"
 	"strings"
 
+	"github.com/linuxsuren/api-testing/pkg/secret"
 	"github.com/linuxsuren/api-testing/pkg/util"
 
 	"gopkg.in/yaml.v3"
@@ -246,6 +247,15 @@ func (s *storeFactory) CreateStore(store Store) (err error) {
 }
 
 func (s *storeFactory) save(storeConfig *StoreConfig) (err error) {
+	var c secret.Cipher
+	if c, err = secret.GetCipher(s.configDir); err == nil {
+		err = s.saveWithCipher(storeConfig, c)
+	}
+	return
+}
+
+// saveWithCipher saves the store config with the encrypted passwords
+func (s *storeFactory) saveWithCipher(storeConfig *StoreConfig, c secret.Cipher) (err error) {
 	for i, item := range storeConfig.Stores {
 		if item.Kind.Name != "" {
 			storeConfig.Stores[i].Kind.Enabled = true
@@ -265,15 +275,33 @@ func (s *storeFactory) save(storeConfig *StoreConfig) (err error) {
 		}
 	}
 
+	encryptedConfig := *storeConfig
+	encryptedConfig.Stores = make([]Store, len(storeConfig.Stores))
+	for i, item := range storeConfig.Stores {
+		if item.Password, err = c.Encrypt(item.Password); err != nil {
+			return
+		}
+		encryptedConfig.Stores[i] = item
+	}
+
 	if err = os.MkdirAll(s.configDir, 0755); err == nil {
 		var data []byte
-		if data, err = yaml.Marshal(storeConfig); err == nil {
-			err = os.WriteFile(path.Join(s.configDir, "stores.yaml"), data, 0644)
+		if data, err = yaml.Marshal(&encryptedConfig); err == nil {
+			err = os.WriteFile(path.Join(s.configDir, "stores.yaml"), data, 0600)
 		}
 	}
 	return
 }
 
+// RotateSecret re-encrypts the passwords of the stores with the new cipher
+func (s *storeFactory) RotateSecret(oldCipher, newCipher secret.Cipher) (err error) {
+	var storeConfig *StoreConfig
+	if storeConfig, err = s.getStoreConfigWithCipher(oldCipher); err == nil && len(storeConfig.Stores) > 0 {
+		err = s.saveWithCipher(storeConfig, newCipher)
+	}
+	return
+}
+
 func (s *storeFactory) GetStoreKinds() (kinds []StoreKind, err error) {
 	storeConfig := &StoreConfig{}
 
@@ -287,10 +315,27 @@ func (s *storeFactory) GetStoreKinds() (kinds []StoreKind, err error) {
 }
 
 func (s *storeFactory) getStoreConfig() (storeConfig *StoreConfig, err error) {
+	var c secret.Cipher
+	if c, err = secret.GetCipher(s.configDir); err == nil {
+		storeConfig, err = s.getStoreConfigWithCipher(c)
+	}
+	return
+}
+
+// getStoreConfigWithCipher returns the store config with the decrypted passwords
+func (s *storeFactory) getStoreConfigWithCipher(c secret.Cipher) (storeConfig *StoreConfig, err error) {
 	storeConfig = &StoreConfig{}
 	var data []byte
 	if data, err = os.ReadFile(path.Join(s.configDir, "stores.yaml")); err == nil {
-		err = yaml.Unmarshal(data, storeConfig)
+		if err = yaml.Unmarshal(data, storeConfig); err == nil {
+			for i := range storeConfig.Stores {
+				item := &storeConfig.Stores[i]
+				if item.Password, err = c.Decrypt(item.Password); err != nil {
+					err = fmt.Errorf("failed to decrypt the password of store %s: %v", item.Name, err)
+					return
+				}
+			}
+		}
 	} else {
 		err = nil
 	}
@@ -18,8 +18,11 @@ package testing
 
 import (
 	"os"
+	"path"
 	"testing"
 
+	"github.com/linuxsuren/api-testing/pkg/secret"
+	"github.com/linuxsuren/api-testing/pkg/util"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -133,6 +136,41 @@ func TestStoreFactory(t *testing.T) {
 	})
 }
 
+func TestStoreFactoryWithMasterKey(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, secret.SaveMasterKey(dir, "key"))
+
+	factory := NewStoreFactory(dir)
+	assert.NoError(t, factory.CreateStore(Store{Name: "db", Password: "plain-password"}))
+
+	data, err := os.ReadFile(path.Join(dir, "stores.yaml"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "plain-password")
+
+	var store *Store
+	store, err = factory.GetStore("db")
+	assert.NoError(t, err)
+	assert.Equal(t, "plain-password", store.Password)
+
+	assert.NoError(t, factory.UpdateStore(Store{Name: "db", Password: util.PasswordPlaceholder}))
+	store, err = factory.GetStore("db")
+	assert.NoError(t, err)
+	assert.Equal(t, "plain-password", store.Password)
+
+	t.Run("rotate", func(t *testing.T) {
+		err := factory.(secret.Rotator).RotateSecret(secret.NewCipher("key"), secret.NewCipher("new"))
+		assert.NoError(t, err)
+
+		_, err = factory.GetStore("db")
+		assert.Error(t, err)
+
+		assert.NoError(t, secret.SaveMasterKey(dir, "new"))
+		store, err := factory.GetStore("db")
+		assert.NoError(t, err)
+		assert.Equal(t, "plain-password", store.Password)
+	})
+}
+
 var sampleStoreMap = map[string]string{
 	"name":        "test",
 	"owner":       "",
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import "fmt"
+
+// ContextKeyVariables is the key of the captured variables, such as: {{.vars.token}}
+const ContextKeyVariables = "vars"
+
+// Variables holds the captured variables of a test run.
+// The values keep the original types, such as: string, float64, bool, map, slice.
+type Variables map[string]interface{}
+
+// Set sets the value of a variable
+func (v Variables) Set(name string, value interface{}) {
+	v[name] = value
+}
+
+// Get returns the value of a variable
+func (v Variables) Get(name string) (value interface{}, err error) {
+	var ok bool
+	if value, ok = v[name]; !ok {
+		err = fmt.Errorf("variable %q is not captured", name)
+	}
+	return
+}
+
+// GetVariables returns the variables of the data context, the variables will be added if not exist
+func GetVariables(dataContext interface{}) (vars Variables) {
+	ctx, ok := dataContext.(map[string]interface{})
+	if !ok {
+		return Variables{}
+	}
+
+	if vars, ok = ctx[ContextKeyVariables].(Variables); !ok {
+		vars = Variables{}
+		ctx[ContextKeyVariables] = vars
+	}
+	return
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2026 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVariables(t *testing.T) {
+	dataContext := map[string]interface{}{}
+	vars := GetVariables(dataContext)
+	assert.Empty(t, vars)
+	assert.Contains(t, dataContext, ContextKeyVariables)
+
+	vars.Set("count", 1)
+	assert.Equal(t, Variables{"count": 1}, GetVariables(dataContext))
+
+	value, err := vars.Get("count")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+
+	_, err = vars.Get("fake")
+	assert.ErrorContains(t, err, `variable "fake" is not captured`)
+
+	// not a map data context
+	assert.Equal(t, Variables{}, GetVariables(nil))
+}
@@ -45,7 +45,8 @@ func LoadProtoFiles(protoFile string) (targetProtoFile string, importPath []stri
 	}
 
 	utilLogger.Info("start to download proto file", "file", protoFile)
-	resp, err := GetDefaultCachedHTTPClient().Get(protoFile)
+	// the file is downloaded every time, the compiled descriptors are cached by the caller
+	resp, err := http.Get(protoFile)
 	if err != nil {
 		return
 	}